@@ -0,0 +1,52 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dist
+
+import "math/rand/v2"
+
+// ChiSquared represents a chi-squared distribution
+type ChiSquared struct {
+	K   float64    // Degrees of freedom (must be > 0)
+	Rng *rand.Rand // Random generator (required)
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (c ChiSquared) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return gammaIncReg(c.K/2, x/2)
+}
+
+// Survival computes 1 - CDF(x) without cancellation, for small p-values.
+func (c ChiSquared) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return gammaIncRegComp(c.K/2, x/2)
+}
+
+// Rand returns a random sample drawn from the distribution
+func (c ChiSquared) Rand() float64 {
+	return Gamma{Alpha: c.K / 2, Beta: 0.5, Rng: c.Rng}.Rand()
+}
@@ -0,0 +1,93 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dist
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// kolmogorovTerms bounds the series terms, far more than needed for double precision
+const kolmogorovTerms = 100
+
+// Kolmogorov represents the Kolmogorov distribution, the limit of sqrt(n) times the
+// Kolmogorov-Smirnov statistic of n samples
+type Kolmogorov struct {
+	Rng *rand.Rand // Random generator (required)
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (k Kolmogorov) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x < 1 {
+		// Jacobi theta form converges fast for small x
+		var sum float64
+		for i := 1; i <= kolmogorovTerms; i++ {
+			odd := float64(2*i - 1)
+			term := math.Exp(-odd * odd * math.Pi * math.Pi / (8 * x * x))
+			sum += term
+			if term < 1e-17*sum {
+				break
+			}
+		}
+		return math.Sqrt(2*math.Pi) / x * sum
+	}
+	return 1 - k.Survival(x)
+}
+
+// Survival computes 1 - CDF(x) without cancellation, for small p-values.
+func (k Kolmogorov) Survival(x float64) float64 {
+	if x < 1 {
+		return 1 - k.CDF(x)
+	}
+	var sum float64
+	sign := 1.0
+	for i := 1; i <= kolmogorovTerms; i++ {
+		fi := float64(i)
+		term := math.Exp(-2 * fi * fi * x * x)
+		sum += sign * term
+		if term < 1e-17*sum {
+			break
+		}
+		sign = -sign
+	}
+	return 2 * sum
+}
+
+// Rand returns a random sample drawn from the distribution
+func (k Kolmogorov) Rand() float64 {
+	// Invert the CDF by bisection, the CDF is 1 to double precision beyond x = 7
+	u := k.Rng.Float64()
+	lo, hi := 0.0, 7.0
+	for i := 0; i < 64; i++ {
+		mid := (lo + hi) / 2
+		if k.CDF(mid) < u {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
@@ -0,0 +1,232 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package asmcheck finds fused multiply-add instructions on the code paths that must stay
+// bit-identical across platforms
+// It compiles a package for every architecture whose compiler fuses, builds the call graph
+// of the module functions from the compiler's relocations, and reports the fused instructions
+// of every function reachable from the entry points
+package asmcheck
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// module is the import path prefix of the functions that are scanned
+const module = "github.com/andrei-cosmin/dixe"
+
+// Arches are the architectures whose compilers fuse multiply-adds
+var Arches = []string{"arm64", "ppc64le", "s390x", "riscv64"}
+
+// fusedInstr matches the fused multiply-add mnemonics of those architectures, but not FMAX or FMIN
+var fusedInstr = regexp.MustCompile(`\s(FN?M(ADD|SUB)[A-Z]*|WFN?M[AS]D?B)\s`)
+
+// Finding is a fused instruction in a reachable function
+type Finding struct {
+	// Symbol is the function containing the instruction
+	Symbol string
+
+	// Instruction is the assembly line
+	Instruction string
+}
+
+// String returns the function and instruction
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Symbol, f.Instruction)
+}
+
+// Check fails the test on a fused instruction reachable from the entry symbols of the package
+// in the working directory, on any fusing architecture
+// Skipped under -short and when the go tool is missing
+func Check(t *testing.T, entry func(symbol string) bool) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("compiles the package for several architectures")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not available")
+	}
+	for _, arch := range Arches {
+		t.Run(arch, func(t *testing.T) {
+			findings, err := Fused(arch, entry)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range findings {
+				t.Errorf("fused instruction in %s", f)
+			}
+		})
+	}
+}
+
+// Fused compiles the package in the working directory for arch and returns the fused
+// instructions of the module functions reachable from the entry symbols
+// Functions are reachable through direct calls, function values and the methods of every
+// type converted to an interface, as the linker's dead code elimination sees them
+func Fused(arch string, entry func(symbol string) bool) ([]Finding, error) {
+	cmd := exec.Command("go", "build", "-gcflags="+module+"/...=-S", ".")
+	cmd.Env = append(cmd.Environ(), "GOOS=linux", "GOARCH="+arch, "CGO_ENABLED=0")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("asmcheck: build for %s failed: %v\n%s", arch, err, out)
+	}
+	p := parse(out)
+
+	var findings []Finding
+	for _, sym := range p.reachable(entry) {
+		if !strings.HasPrefix(sym, module+"/") {
+			continue
+		}
+		for _, instr := range p.fused[sym] {
+			findings = append(findings, Finding{Symbol: sym, Instruction: instr})
+		}
+	}
+	return findings, nil
+}
+
+// program is the call graph of the compiled functions
+type program struct {
+	funcs  []string
+	edges  map[string][]string
+	ifaces map[string][]string
+	fused  map[string][]string
+}
+
+// parse reads the compiler's assembly listing
+func parse(listing []byte) *program {
+	p := &program{
+		edges:  make(map[string][]string),
+		ifaces: make(map[string][]string),
+		fused:  make(map[string][]string),
+	}
+	var current string
+	scanner := bufio.NewScanner(bytes.NewReader(listing))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "\t") {
+			// A symbol header: only function bodies are followed
+			current = ""
+			if fields := strings.Fields(line); len(fields) > 1 && fields[1] == "STEXT" {
+				current = fields[0]
+				p.funcs = append(p.funcs, current)
+			}
+			continue
+		}
+		if current == "" {
+			continue
+		}
+		if kind, target, ok := relocation(line); ok {
+			if kind == "R_USEIFACE" {
+				p.ifaces[current] = append(p.ifaces[current], strings.TrimPrefix(target, "type:"))
+			} else {
+				p.edges[current] = append(p.edges[current], strings.TrimSuffix(target, "·f"))
+			}
+			continue
+		}
+		if fusedInstr.MatchString(line) {
+			p.fused[current] = append(p.fused[current], strings.TrimSpace(line))
+		}
+	}
+	return p
+}
+
+// relocation parses a relocation line such as "rel 40+4 t=R_CALLARM64 pkg.f+0"
+func relocation(line string) (kind, target string, ok bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(line), "rel ")
+	if !ok {
+		return "", "", false
+	}
+	_, rest, ok = strings.Cut(rest, " t=")
+	if !ok {
+		return "", "", false
+	}
+	kind, target, ok = strings.Cut(rest, " ")
+	if i := strings.LastIndexByte(target, '+'); i >= 0 {
+		target = target[:i]
+	}
+	return kind, target, ok
+}
+
+// reachable returns the functions reachable from the entry symbols, in listing order
+func (p *program) reachable(entry func(string) bool) []string {
+	methods := p.methods()
+	seen := make(map[string]bool)
+	var stack []string
+	visit := func(sym string) {
+		if !seen[sym] {
+			seen[sym] = true
+			stack = append(stack, sym)
+		}
+	}
+	for _, sym := range p.funcs {
+		if entry(sym) {
+			visit(sym)
+		}
+	}
+	for len(stack) > 0 {
+		sym := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, next := range p.edges[sym] {
+			visit(next)
+		}
+		for _, typ := range p.ifaces[sym] {
+			for _, m := range methods[strings.TrimPrefix(typ, "*")] {
+				visit(m)
+			}
+		}
+	}
+
+	var result []string
+	for _, sym := range p.funcs {
+		if seen[sym] {
+			result = append(result, sym)
+		}
+	}
+	return result
+}
+
+// methods maps each named type to its method symbols, for value and pointer receivers
+func (p *program) methods() map[string][]string {
+	methods := make(map[string][]string)
+	for _, sym := range p.funcs {
+		dot := strings.LastIndexByte(sym, '.')
+		if dot < 0 {
+			continue
+		}
+		recv := sym[:dot]
+		if open := strings.Index(recv, ".(*"); open >= 0 && strings.HasSuffix(recv, ")") {
+			recv = recv[:open+1] + recv[open+3:len(recv)-1]
+		} else if slash := strings.LastIndexByte(recv, '/'); !strings.Contains(recv[slash+1:], ".") {
+			// A package-level function, not a method
+			continue
+		}
+		methods[recv] = append(methods[recv], sym)
+	}
+	return methods
+}
@@ -0,0 +1,65 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package asmcheck
+
+import (
+	"slices"
+	"testing"
+)
+
+// listing is a trimmed compiler listing: entry calls helper and converts impl to an interface,
+// whose method fuses; unused fuses too but is never reached
+const listing = `example.com/p.entry STEXT size=8 args=0x0 locals=0x0
+	0x0000 00000 (p.go:1)	CALL	example.com/p.helper(SB)
+	rel 0+4 t=R_CALLARM64 example.com/p.helper+0
+	rel 0+0 t=R_USEIFACE type:example.com/p.impl+0
+example.com/p.helper STEXT size=8 args=0x0 locals=0x0
+	0x0000 00000 (p.go:2)	FMAXD	F0, F1, F2
+	rel 0+8 t=R_ADDRARM64 example.com/p.helper.func1·f+0
+example.com/p.helper.func1 STEXT size=8 args=0x0 locals=0x0
+	0x0000 00000 (p.go:3)	FMSUBD	F0, F1, F2, F3
+example.com/p.impl.Method STEXT size=8 args=0x0 locals=0x0
+	0x0000 00000 (p.go:4)	FMADDD	F0, F1, F2, F3
+example.com/p.unused STEXT size=8 args=0x0 locals=0x0
+	0x0000 00000 (p.go:5)	FMADDD	F0, F1, F2, F3
+go:itab.example.com/p.impl,example.com/p.I SRODATA dupok size=32
+	rel 24+8 t=R_METHODOFF example.com/p.unused+0
+`
+
+func TestReachable(t *testing.T) {
+	p := parse([]byte(listing))
+	got := p.reachable(func(symbol string) bool { return symbol == "example.com/p.entry" })
+	want := []string{"example.com/p.entry", "example.com/p.helper", "example.com/p.helper.func1", "example.com/p.impl.Method"}
+	if !slices.Equal(got, want) {
+		t.Errorf("reachable: got %v, want %v", got, want)
+	}
+
+	if n := len(p.fused["example.com/p.helper"]); n != 0 {
+		t.Errorf("FMAX is not a fused multiply-add, got %d findings", n)
+	}
+	for _, sym := range []string{"example.com/p.helper.func1", "example.com/p.impl.Method", "example.com/p.unused"} {
+		if len(p.fused[sym]) != 1 {
+			t.Errorf("%s: expected one fused instruction", sym)
+		}
+	}
+}
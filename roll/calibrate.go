@@ -0,0 +1,208 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnreachable is returned when no weight in [0, 1] achieves a calibration target
+var ErrUnreachable = errors.New("calibrate: target unreachable")
+
+const (
+	// calibrateGrid is the number of weight steps scanned for a bracket before bisecting
+	calibrateGrid = 100
+
+	// calibrateSteps is the number of bisection steps, enough for full float64 precision
+	calibrateSteps = 60
+
+	// meanIntervals is the number of intervals used to integrate float means
+	meanIntervals = 1024
+)
+
+// targetKind selects the outcome measured by a Target
+type targetKind int
+
+const (
+	targetMean targetKind = iota
+	targetPercentile
+	targetAtLeast
+)
+
+// Target is a roll outcome to calibrate the weight for
+type Target struct {
+	kind    targetKind
+	value   float64
+	percent float64
+}
+
+// TargetMean targets the mean roll
+func TargetMean(v float64) Target {
+	return Target{kind: targetMean, value: v}
+}
+
+// TargetMedian targets the median roll
+func TargetMedian(v float64) Target {
+	return TargetPercentile(50, v)
+}
+
+// TargetPercentile targets the roll at the percentile p (0-100)
+// For int rolls the percentile falls in the middle of the bucket of v
+func TargetPercentile(p, v float64) Target {
+	return Target{kind: targetPercentile, value: v, percent: p}
+}
+
+// TargetAtLeast targets the chance (0-100%) of rolling at least v
+func TargetAtLeast(v, chance float64) Target {
+	return Target{kind: targetAtLeast, value: v, percent: chance}
+}
+
+// String describes the target
+func (t Target) String() string {
+	switch t.kind {
+	case targetMean:
+		return fmt.Sprintf("mean %v", t.value)
+	case targetPercentile:
+		return fmt.Sprintf("percentile %v at %v", t.percent, t.value)
+	default:
+		return fmt.Sprintf("%v%% chance of at least %v", t.percent, t.value)
+	}
+}
+
+// measured describes the quantity compared with the target while solving
+func (t Target) measured() string {
+	switch t.kind {
+	case targetMean:
+		return "the mean"
+	case targetPercentile:
+		return fmt.Sprintf("the percentage of rolls up to %v", t.value)
+	default:
+		return fmt.Sprintf("the chance of at least %v", t.value)
+	}
+}
+
+// Calibrate finds the weight for which the source distribution achieves the target on the range
+// It uses the exact CDF, scanning [0, 1] for the first weight bracketing the target and bisecting it
+// Returns an error wrapping ErrUnreachable with the reachable interval if no weight achieves it
+func (s *Source[T]) Calibrate(r Range[T], target Target) (float64, error) {
+	cfg := distConfigFromOptions(s.opts)
+	distribution := cfg.distribution()
+	p := FloatParams{Range: s.floatRange(r)}
+	_, discrete := any(r).(IntRange)
+
+	var measure func(w float64) float64
+	goal := target.value
+	switch target.kind {
+	case targetMean:
+		measure = func(w float64) float64 {
+			p.Weight = w
+			return mean(distribution, p, discrete)
+		}
+	case targetPercentile:
+		// The weight moving the percentile to v is the one putting percent of the mass below v
+		x := target.value
+		if discrete {
+			x += 0.5
+		}
+		measure = func(w float64) float64 {
+			p.Weight = w
+			return distribution.CDF(x, p) * 100
+		}
+		goal = target.percent
+	case targetAtLeast:
+		measure = func(w float64) float64 {
+			p.Weight = w
+			return (1 - distribution.CDF(target.value, p)) * 100
+		}
+		goal = target.percent
+	}
+
+	w, lo, hi, ok := solveWeight(measure, goal)
+	if !ok {
+		return w, fmt.Errorf("%w: %v, %s ranges over [%.6g, %.6g]", ErrUnreachable, target, target.measured(), lo, hi)
+	}
+	return w, nil
+}
+
+// solveWeight finds a weight in [0, 1] where measure equals goal
+// On failure it returns the closest weight and the range of the measure over the grid
+func solveWeight(measure func(float64) float64, goal float64) (w, lo, hi float64, ok bool) {
+	const tolerance = 1e-9
+	lo, hi = math.Inf(1), math.Inf(-1)
+	best, bestDiff := 0.0, math.Inf(1)
+
+	prevW, prevDiff := 0.0, measure(0)-goal
+	for i := 0; i <= calibrateGrid; i++ {
+		w := float64(i) / calibrateGrid
+		v := measure(w)
+		diff := v - goal
+		lo, hi = min(lo, v), max(hi, v)
+		if math.Abs(diff) < bestDiff {
+			best, bestDiff = w, math.Abs(diff)
+		}
+		if diff == 0 {
+			return w, lo, hi, true
+		}
+		if i > 0 && (diff < 0) != (prevDiff < 0) {
+			return bisectWeight(measure, goal, prevW, w, prevDiff < 0), lo, hi, true
+		}
+		prevW, prevDiff = w, diff
+	}
+	return best, lo, hi, bestDiff <= tolerance*max(1, math.Abs(goal))
+}
+
+// bisectWeight narrows a bracket [lo, hi] around the goal
+func bisectWeight(measure func(float64) float64, goal, lo, hi float64, increasing bool) float64 {
+	for i := 0; i < calibrateSteps; i++ {
+		mid := (lo + hi) / 2
+		if (measure(mid) < goal) == increasing {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// mean returns the exact mean roll of the distribution
+// Discrete rolls sum the bucket probabilities, continuous ones integrate the survival function
+func mean(distribution Distribution, p FloatParams, discrete bool) float64 {
+	if discrete {
+		var sum float64
+		for v := p.Lower; v < p.Upper; v++ {
+			sum += v * (distribution.CDF(v+1, p) - distribution.CDF(v, p))
+		}
+		return sum
+	}
+
+	// Midpoint rule on E[X] = lower + integral of (1 - F(x)) over [lower, upper)
+	// It never evaluates the endpoints, where point masses make the CDF jump
+	h := (p.Upper - p.Lower) / meanIntervals
+	var integral float64
+	for i := 0; i < meanIntervals; i++ {
+		integral += 1 - distribution.CDF(p.Lower+(float64(i)+0.5)*h, p)
+	}
+	return p.Lower + integral*h
+}
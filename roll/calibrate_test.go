@@ -0,0 +1,92 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCalibrate(t *testing.T) {
+	tests := []struct {
+		name   string
+		dist   Distribution
+		target Target
+		check  func(c *IntDistCaster) float64
+		want   float64
+	}{
+		{"WeightedHigh mean", WeightedHigh(), TargetMean(65), func(c *IntDistCaster) float64 {
+			return mean(c.cfg.Dist, FloatParams{Range: intFloatRange(D100()), Weight: c.cfg.Weight}, true)
+		}, 65},
+		{"Skewed percentile", Skewed(), TargetPercentile(45, 40), func(c *IntDistCaster) float64 {
+			return c.cfg.Dist.CDF(40.5, FloatParams{Range: intFloatRange(D100()), Weight: c.cfg.Weight}) * 100
+		}, 45},
+		{"WeightedMax at least", WeightedMax(), TargetAtLeast(90, 40), func(c *IntDistCaster) float64 {
+			odds := c.Odds(D100())
+			var chance float64
+			for v := 90; v <= 100; v++ {
+				chance += odds.Probabilities[v]
+			}
+			return chance
+		}, 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := NewIntSource("test-seed").Dist(tt.dist)
+			w, err := src.Calibrate(D100(), tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.check(src.Weight(w).SaltDist("check")); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("weight %v: got %v, want %v", w, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalibrateFloatMean(t *testing.T) {
+	src := NewFloatSource("test-seed").Dist(WeightedMin())
+	w, err := src.Calibrate(FloatRange{Lower: 0, Upper: 10}, TargetMean(2.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// WeightedMin mixes a point mass at Lower with a Beta(1, 2), whose mean is a third of the range
+	if want := 1 - 2.5/(10.0/3); math.Abs(w-want) > 1e-4 {
+		t.Errorf("weight: got %v, want %v", w, want)
+	}
+}
+
+func TestCalibrateUnreachable(t *testing.T) {
+	_, err := NewIntSource("test-seed").Dist(Normal()).Calibrate(D100(), TargetMean(65))
+	if !errors.Is(err, ErrUnreachable) {
+		t.Errorf("a symmetric distribution cannot move its mean, got %v", err)
+	}
+
+	_, err = NewIntSource("test-seed").Dist(Skewed()).Calibrate(D100(), TargetMedian(30))
+	if !errors.Is(err, ErrUnreachable) {
+		t.Errorf("the skewed median cannot reach 30, got %v", err)
+	}
+}
@@ -22,7 +22,13 @@
 
 package roll
 
-import "math/rand/v2"
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/andrei-cosmin/dixe/dist"
+	"github.com/andrei-cosmin/dixe/strict"
+)
 
 // FloatDistCaster is a distribution caster for float64 values
 type FloatDistCaster = DistCaster[float64]
@@ -33,9 +39,18 @@ type IntDistCaster = DistCaster[int]
 // DistCaster holds a derived RNG and config for distribution-based rolling
 type DistCaster[T constraint] struct {
 	rng        *rand.Rand
+	src        rand.Source
+	key        hashKey
 	cfg        distConfig[T]
 	floatRange func(Range[T]) FloatRange
 	convert    func(float64) T
+
+	// latent is the AR(1) state driving correlated rolls, valid once primed is set
+	latent float64
+	primed bool
+
+	// rolls counts the values rolled so far, see Fingerprint
+	rolls uint64
 }
 
 // Dist sets the distribution
@@ -76,6 +91,30 @@ func (c *DistCaster[T]) UpperExplosions(n int) *DistCaster[T] {
 	return c
 }
 
+// Explode sets the explosion policies, applied after the RerollBelow/RerollAbove chains
+func (c *DistCaster[T]) Explode(policies ...Explosion[T]) *DistCaster[T] {
+	c.cfg.Explosions = uniqueNames(policies)
+	return c
+}
+
+// Reroll sets the reroll policies, applied to the first roll before explosions
+func (c *DistCaster[T]) Reroll(policies ...Reroll[T]) *DistCaster[T] {
+	c.cfg.Rerolls = policies
+	return c
+}
+
+// Correlation sets the correlation between successive rolls
+func (c *DistCaster[T]) Correlation(rho float64) *DistCaster[T] {
+	c.cfg.Correlation = rho
+	return c
+}
+
+// Strict sets platform-independent sampling and CDFs (see StrictDistribution)
+func (c *DistCaster[T]) Strict(on bool) *DistCaster[T] {
+	c.cfg.Strict = on
+	return c
+}
+
 // With applies options to the caster config
 // Preserves existing Dist if opts.Dist is nil
 func (c *DistCaster[T]) With(opts Options[T]) *DistCaster[T] {
@@ -92,6 +131,43 @@ func (c *DistCaster[T]) Fork() DistCaster[T] {
 	return *c
 }
 
+// At returns a caster for the roll at the given index, without advancing this caster
+// Each index has its own RNG keyed by BLAKE3(key, index), so any server holding the same
+// seed, salt and options agrees on the value at an index regardless of what was rolled before
+// The returned caster supports explosions and every distribution; further rolls on it
+// continue the indexed stream (explosion rolls draw from it too)
+func (c *DistCaster[T]) At(index uint64) *DistCaster[T] {
+	src, key := c.key.derive([]int64{int64(index)})
+	return &DistCaster[T]{
+		rng:        rand.New(src),
+		src:        src,
+		key:        key,
+		cfg:        c.cfg,
+		floatRange: c.floatRange,
+		convert:    c.convert,
+	}
+}
+
+// Derive creates a child caster for a namespace path, starting a fresh stream
+// The child key is a length-prefixed BLAKE3 derivation of this caster's key (see Source.Derive)
+// The child copies the current config and can be configured independently
+// Panics if the path is empty, since the child would replay this caster's stream
+func (c *DistCaster[T]) Derive(path ...string) *DistCaster[T] {
+	if len(path) == 0 {
+		panic("derive: empty path")
+	}
+	key := derivePath(c.key.bytes[:], path)
+	src := rand.NewChaCha8(key)
+	return &DistCaster[T]{
+		rng:        rand.New(src),
+		src:        src,
+		key:        newHashKey(key),
+		cfg:        c.cfg,
+		floatRange: c.floatRange,
+		convert:    c.convert,
+	}
+}
+
 // One rolls a single value and returns the result
 func (c *DistCaster[T]) One(r ...Range[T]) Result[T] {
 	distRange := defaultRange(r...)
@@ -104,23 +180,34 @@ func (c *DistCaster[T]) One(r ...Range[T]) Result[T] {
 	}
 
 	// Roll the first value
-	firstRoll := c.convert(rollFloat(c.cfg.Dist, p))
+	firstRoll := c.convert(c.rollFirst(p))
+	c.rolls++
+
+	// Replace the first roll while reroll policies trigger
+	firstRoll, discarded := reroll(c.cfg.Rerolls, firstRoll, func() T {
+		return c.convert(rollFloat(c.cfg.distribution(), p))
+	})
 
 	// Generate explosions
 	lowerRolls := c.processExplosion(firstRoll, p, c.cfg.shouldExplodeLower)
 	upperRolls := c.processExplosion(firstRoll, p, c.cfg.shouldExplodeUpper)
 
+	// Run the explosion policies, each extra roll on its own range
+	extras := legacyExtras(lowerRolls, upperRolls)
+	extras = append(extras, explode(c.cfg.Explosions, firstRoll, distRange, len(extras)+1, func(r Range[T]) T {
+		extra := p
+		extra.Range = c.floatRange(r)
+		return c.convert(rollFloat(c.cfg.distribution(), extra))
+	})...)
+
 	// Combine all rolls
-	total := len(lowerRolls) + len(upperRolls) + 1
+	total := len(extras) + 1
 	allRolls := make([]T, total)
 	allRolls[0] = firstRoll
-	copy(allRolls[1:], lowerRolls)
-	copy(allRolls[1+len(lowerRolls):], upperRolls)
-
-	// Calculate the sum
-	var sum T
-	for _, v := range allRolls {
-		sum += v
+	sum := firstRoll
+	for i, e := range extras {
+		allRolls[i+1] = e.Value
+		sum += e.Contribution
 	}
 
 	// Return the result
@@ -131,6 +218,8 @@ func (c *DistCaster[T]) One(r ...Range[T]) Result[T] {
 		LowerExplosions: len(lowerRolls),
 		UpperExplosions: len(upperRolls),
 		Rolls:           allRolls,
+		Extras:          extras,
+		Discarded:       discarded,
 	}
 }
 
@@ -145,6 +234,8 @@ func (c *DistCaster[T]) Multiple(count int, r ...Range[T]) []Result[T] {
 }
 
 // Odds calculates the probability distribution for a roll
+// Reroll policies are applied exactly on int ranges; float ranges evaluate them per unit bucket
+// With reroll policies, explosion chances are taken from the adjusted distribution
 func (c *DistCaster[T]) Odds(r ...Range[T]) Odds {
 	distRange := defaultRange(r...)
 	result := Odds{
@@ -156,30 +247,154 @@ func (c *DistCaster[T]) Odds(r ...Range[T]) Odds {
 		Weight: c.cfg.Weight,
 		Rng:    nil,
 	}
+	distribution := c.cfg.distribution()
 
 	for v := int(distRange.Lower); v <= int(distRange.Upper); v++ {
-		prob := c.cfg.Dist.CDF(float64(v+1), p) - c.cfg.Dist.CDF(float64(v), p)
+		prob := distribution.CDF(float64(v+1), p) - distribution.CDF(float64(v), p)
 		result.Probabilities[v] = prob * 100
 	}
 
+	if len(c.cfg.Rerolls) > 0 {
+		return c.rerolledOdds(result, distRange)
+	}
+
 	explosionRange := c.floatRange(Range[T]{Lower: c.cfg.RerollBelow, Upper: c.cfg.RerollAbove})
 
 	if c.cfg.MaxLowerExplosions > 0 {
-		result.LowerExplosionChance = c.cfg.Dist.CDF(explosionRange.Lower, p) * 100
+		result.LowerExplosionChance = distribution.CDF(explosionRange.Lower, p) * 100
 	}
 
 	if c.cfg.MaxUpperExplosions > 0 {
-		result.UpperExplosionChance = (1 - c.cfg.Dist.CDF(explosionRange.Upper, p)) * 100
+		result.UpperExplosionChance = (1 - distribution.CDF(explosionRange.Upper, p)) * 100
+	}
+
+	for _, e := range c.cfg.Explosions {
+		if chance, ok := c.explosionChance(e, distribution, distRange, p); ok && e.max > 0 {
+			if result.ExplosionChances == nil {
+				result.ExplosionChances = make(map[string]float64)
+			}
+			result.ExplosionChances[e.name] = chance * 100
+		}
 	}
 
 	return result
 }
 
+// rerolledOdds adjusts single-roll odds for the reroll policies
+func (c *DistCaster[T]) rerolledOdds(base Odds, r Range[T]) Odds {
+	var values []T
+	var probs []float64
+	for v := int(r.Lower); v <= int(r.Upper); v++ {
+		values = append(values, T(v))
+		probs = append(probs, base.Probabilities[v]/100)
+	}
+	probs = rerollProbabilities(c.cfg.Rerolls, values, probs)
+
+	result := Odds{Probabilities: make(map[int]float64, len(values))}
+	chance := func(triggers func(T) bool) float64 {
+		var sum float64
+		for i, v := range values {
+			if triggers(v) {
+				sum += probs[i]
+			}
+		}
+		return sum * 100
+	}
+	for i, v := range values {
+		result.Probabilities[int(v)] = probs[i] * 100
+	}
+
+	if c.cfg.MaxLowerExplosions > 0 {
+		result.LowerExplosionChance = chance(func(v T) bool { return v < c.cfg.RerollBelow })
+	}
+	if c.cfg.MaxUpperExplosions > 0 {
+		result.UpperExplosionChance = chance(func(v T) bool { return v > c.cfg.RerollAbove })
+	}
+	for _, e := range c.cfg.Explosions {
+		if e.max <= 0 {
+			continue
+		}
+		if result.ExplosionChances == nil {
+			result.ExplosionChances = make(map[string]float64)
+		}
+		result.ExplosionChances[e.name] = chance(func(v T) bool { return e.triggers(v, r) })
+	}
+	return result
+}
+
+// explosionChance returns the probability that a roll on the range triggers the policy
+func (c *DistCaster[T]) explosionChance(e Explosion[T], distribution Distribution, r Range[T], p FloatParams) (float64, bool) {
+	point := func(v T) FloatRange { return c.floatRange(Range[T]{Lower: v, Upper: v}) }
+	switch e.kind {
+	case triggerAbove:
+		return 1 - distribution.CDF(point(e.threshold).Upper, p), true
+	case triggerBelow:
+		return distribution.CDF(point(e.threshold).Lower, p), true
+	case triggerMax:
+		return 1 - distribution.CDF(point(r.Upper).Lower, p), true
+	case triggerMin:
+		return distribution.CDF(point(r.Lower).Upper, p), true
+	}
+	if _, discrete := any(r).(IntRange); !discrete {
+		return 0, false
+	}
+	var chance float64
+	for v := int(r.Lower); v <= int(r.Upper); v++ {
+		if e.triggers(T(v), r) {
+			chance += distribution.CDF(float64(v+1), p) - distribution.CDF(float64(v), p)
+		}
+	}
+	return chance, true
+}
+
+// rollFirst rolls the first value of a roll
+// Correlated casters map the latent AR(1) state through the distribution quantile,
+// so each marginal still follows the configured distribution
+func (c *DistCaster[T]) rollFirst(p FloatParams) float64 {
+	if c.cfg.Correlation == 0 {
+		return rollFloat(c.cfg.distribution(), p)
+	}
+	c.advanceLatent()
+	return quantile(c.cfg.distribution(), c.latentCDF(), p)
+}
+
+// advanceLatent steps the latent standard normal chain: z' = rho*z + sqrt(1-rho^2)*e
+// The first step draws from the stationary distribution directly
+func (c *DistCaster[T]) advanceLatent() {
+	e := c.normFloat64()
+	if !c.primed {
+		c.latent = e
+		c.primed = true
+		return
+	}
+	rho := max(-1, min(1, c.cfg.Correlation))
+	c.latent = float64(rho*c.latent) + float64(math.Sqrt(1-float64(rho*rho))*e)
+}
+
+// normFloat64 draws a standard normal value for the latent chain
+// Strict casters invert the platform-independent normal CDF instead of using the ziggurat
+func (c *DistCaster[T]) normFloat64() float64 {
+	if !c.cfg.Strict {
+		return c.rng.NormFloat64()
+	}
+	// Open interval (0, 1) so the quantile stays finite
+	u := (float64(c.rng.Uint64()>>11) + 0.5) / (1 << 53)
+	return strict.NormQuantile(u)
+}
+
+// latentCDF maps the latent state to a uniform value in [0, 1]
+func (c *DistCaster[T]) latentCDF() float64 {
+	if c.cfg.Strict {
+		return strict.NormCDF(c.latent)
+	}
+	return dist.Normal{Mu: 0, Sigma: 1}.CDF(c.latent)
+}
+
 // processExplosion generates additional rolls while the condition is met
 func (c *DistCaster[T]) processExplosion(currentRoll T, p FloatParams, shouldExplode func(T, int) bool) []T {
 	var rolls []T
 	for shouldExplode(currentRoll, len(rolls)) {
-		currentRoll = c.convert(rollFloat(c.cfg.Dist, p))
+		currentRoll = c.convert(rollFloat(c.cfg.distribution(), p))
 		rolls = append(rolls, currentRoll)
 	}
 	return rolls
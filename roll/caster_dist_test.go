@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCorrelatedMarginals(t *testing.T) {
+	caster := NewIntSource("test-seed").Dist(Normal()).Weight(0.5).Correlation(0.5).SaltDist("test-salt")
+	r := D10()
+	counts := make(map[int]int)
+
+	// Each correlated roll inverts the CDF, so use a smaller sample than testDistribution
+	n := samples / 4
+	for i := 0; i < n; i++ {
+		counts[caster.One(r).First]++
+	}
+
+	expected := caster.Odds(r).Probabilities
+	for bucket := r.Lower; bucket <= r.Upper; bucket++ {
+		empirical := float64(counts[bucket]) / float64(n) * 100.0
+		diff := math.Abs(empirical - expected[bucket])
+		if diff > tolerance*100 {
+			t.Errorf("bucket %d: got %.2f%%, want %.2f%% (±%.0f%%)",
+				bucket, empirical, expected[bucket], tolerance*100)
+		}
+	}
+}
+
+func TestCorrelationMemory(t *testing.T) {
+	tests := []struct {
+		name     string
+		rho      float64
+		min, max float64
+	}{
+		{"independent", 0, -0.05, 0.05},
+		{"drifting", 0.9, 0.8, 0.95},
+		{"alternating", -0.9, -0.95, -0.8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caster := NewFloatSource("test-seed").Correlation(tt.rho).SaltDist("test-salt")
+			values := make([]float64, samples)
+			for i := range values {
+				values[i] = caster.One(FloatRange{Lower: 0, Upper: 1}).First
+			}
+
+			if got := lagOneCorrelation(values); got < tt.min || got > tt.max {
+				t.Errorf("lag-1 correlation %.3f outside [%.2f, %.2f]", got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func lagOneCorrelation(values []float64) float64 {
+	var mean float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	var num, den float64
+	for i, v := range values {
+		den += (v - mean) * (v - mean)
+		if i > 0 {
+			num += (v - mean) * (values[i-1] - mean)
+		}
+	}
+	return num / den
+}
+
+func TestIndexedRolls(t *testing.T) {
+	src := NewIntSource("test-seed").Dist(Skewed()).RerollAbove(18).UpperExplosions(3)
+	caster := src.SaltDist("test-salt")
+	field := src.Field("test-salt")
+
+	for i := uint64(0); i < 1000; i++ {
+		a := caster.At(i).One(D20())
+		b := NewIntSource("test-seed").Dist(Skewed()).RerollAbove(18).UpperExplosions(3).Roll("test-salt", i, D20())
+		c := field.At(int64(i)).One(D20())
+		if a.Sum != b.Sum || a.Sum != c.Sum || len(a.Rolls) != len(b.Rolls) {
+			t.Fatalf("index %d: got %v, %v and %v", i, a.Rolls, b.Rolls, c.Rolls)
+		}
+	}
+
+	fresh := src.SaltDist("test-salt").One(D100())
+	if got := caster.One(D100()); got.First != fresh.First {
+		t.Errorf("At advanced the stream: got %d, want %d", got.First, fresh.First)
+	}
+}
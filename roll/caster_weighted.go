@@ -56,7 +56,12 @@ func ticketsFromWeights[T constraint](weights Weights[T]) []ticket[T] {
 // WeightedCaster holds a derived RNG and config for custom weight-based rolling
 type WeightedCaster[T constraint] struct {
 	rng *rand.Rand
+	src rand.Source
+	key hashKey
 	cfg weightedConfig[T]
+
+	// rolls counts the values rolled so far, see Fingerprint
+	rolls uint64
 }
 
 // Custom sets the custom weights
@@ -92,6 +97,19 @@ func (c *WeightedCaster[T]) UpperExplosions(n int) *WeightedCaster[T] {
 	return c
 }
 
+// Explode sets the explosion policies
+// Weighted casters ignore RerollBelow/RerollAbove but apply explosion policies
+func (c *WeightedCaster[T]) Explode(policies ...Explosion[T]) *WeightedCaster[T] {
+	c.cfg.Explosions = uniqueNames(policies)
+	return c
+}
+
+// Reroll sets the reroll policies, applied to the first roll before explosions
+func (c *WeightedCaster[T]) Reroll(policies ...Reroll[T]) *WeightedCaster[T] {
+	c.cfg.Rerolls = policies
+	return c
+}
+
 // With applies options to the caster config
 // Preserves existing tickets if opts.Custom is nil
 func (c *WeightedCaster[T]) With(opts Options[T]) *WeightedCaster[T] {
@@ -106,7 +124,40 @@ func (c *WeightedCaster[T]) With(opts Options[T]) *WeightedCaster[T] {
 // Fork creates a deep copy of the WeightedCaster
 func (c *WeightedCaster[T]) Fork() WeightedCaster[T] {
 	return WeightedCaster[T]{
-		rng: c.rng,
+		rng:   c.rng,
+		src:   c.src,
+		key:   c.key,
+		cfg:   c.cfg.fork(),
+		rolls: c.rolls,
+	}
+}
+
+// At returns a caster for the roll at the given index, without advancing this caster
+// Each index has its own RNG keyed by BLAKE3(key, index), see DistCaster.At
+func (c *WeightedCaster[T]) At(index uint64) *WeightedCaster[T] {
+	src, key := c.key.derive([]int64{int64(index)})
+	return &WeightedCaster[T]{
+		rng: rand.New(src),
+		src: src,
+		key: key,
+		cfg: c.cfg.fork(),
+	}
+}
+
+// Derive creates a child caster for a namespace path, starting a fresh stream
+// The child key is a length-prefixed BLAKE3 derivation of this caster's key (see Source.Derive)
+// The child copies the current config and can be configured independently
+// Panics if the path is empty, since the child would replay this caster's stream
+func (c *WeightedCaster[T]) Derive(path ...string) *WeightedCaster[T] {
+	if len(path) == 0 {
+		panic("derive: empty path")
+	}
+	key := derivePath(c.key.bytes[:], path)
+	src := rand.NewChaCha8(key)
+	return &WeightedCaster[T]{
+		rng: rand.New(src),
+		src: src,
+		key: newHashKey(key),
 		cfg: c.cfg.fork(),
 	}
 }
@@ -114,14 +165,41 @@ func (c *WeightedCaster[T]) Fork() WeightedCaster[T] {
 // One rolls a single value based on custom weights
 func (c *WeightedCaster[T]) One(_ ...Range[T]) Result[T] {
 	value := c.rollWeighted()
+	c.rolls++
 
+	// Rerolls pick from the full weights again
+	value, discarded := reroll(c.cfg.Rerolls, value, c.rollWeighted)
+
+	if len(c.cfg.Explosions) == 0 {
+		return Result[T]{
+			First:           value,
+			Last:            value,
+			Sum:             value,
+			LowerExplosions: 0,
+			UpperExplosions: 0,
+			Rolls:           []T{value},
+			Discarded:       discarded,
+		}
+	}
+
+	// Extra rolls pick from the weights within their range
+	extras := explode(c.cfg.Explosions, value, c.span(), 1, func(r Range[T]) T {
+		return c.rollWeightedIn(r)
+	})
+	rolls := make([]T, len(extras)+1)
+	rolls[0] = value
+	sum := value
+	for i, e := range extras {
+		rolls[i+1] = e.Value
+		sum += e.Contribution
+	}
 	return Result[T]{
-		First:           value,
-		Last:            value,
-		Sum:             value,
-		LowerExplosions: 0,
-		UpperExplosions: 0,
-		Rolls:           []T{value},
+		First:     value,
+		Last:      rolls[len(rolls)-1],
+		Sum:       sum,
+		Rolls:     rolls,
+		Extras:    extras,
+		Discarded: discarded,
 	}
 }
 
@@ -149,13 +227,81 @@ func (c *WeightedCaster[T]) Odds(_ ...Range[T]) Odds {
 		return result
 	}
 
-	for _, t := range c.cfg.tickets {
-		result.Probabilities[int(t.value)] = (t.weight / totalWeight) * 100
+	values := make([]T, len(c.cfg.tickets))
+	probs := make([]float64, len(c.cfg.tickets))
+	for i, t := range c.cfg.tickets {
+		values[i], probs[i] = t.value, t.weight/totalWeight
+	}
+	if len(c.cfg.Rerolls) > 0 {
+		probs = rerollProbabilities(c.cfg.Rerolls, values, probs)
+	}
+	for i, v := range values {
+		result.Probabilities[int(v)] = probs[i] * 100
+	}
+
+	span := c.span()
+	for _, e := range c.cfg.Explosions {
+		if e.max <= 0 {
+			continue
+		}
+		if result.ExplosionChances == nil {
+			result.ExplosionChances = make(map[string]float64)
+		}
+		var chance float64
+		for i, v := range values {
+			if e.triggers(v, span) {
+				chance += probs[i]
+			}
+		}
+		result.ExplosionChances[e.name] = chance * 100
 	}
 
 	return result
 }
 
+// span returns the range from the lowest to the highest value with a positive weight
+func (c *WeightedCaster[T]) span() Range[T] {
+	var span Range[T]
+	found := false
+	for _, t := range c.cfg.tickets {
+		if t.weight <= 0 {
+			continue
+		}
+		if !found {
+			span.Lower, found = t.value, true
+		}
+		span.Upper = t.value
+	}
+	return span
+}
+
+// rollWeightedIn selects a value among the weights within the range
+// Returns the range lower bound if no weighted value lies in it
+func (c *WeightedCaster[T]) rollWeightedIn(r Range[T]) T {
+	var totalWeight float64
+	for _, t := range c.cfg.tickets {
+		if t.value >= r.Lower && t.value <= r.Upper {
+			totalWeight += t.weight
+		}
+	}
+
+	rv := c.rng.Float64() * totalWeight
+
+	var cumulative float64
+	last := r.Lower
+	for _, t := range c.cfg.tickets {
+		if t.value < r.Lower || t.value > r.Upper {
+			continue
+		}
+		cumulative += t.weight
+		last = t.value
+		if rv < cumulative {
+			return t.value
+		}
+	}
+	return last
+}
+
 // rollWeighted selects a value based on custom probability weights (deterministic order)
 func (c *WeightedCaster[T]) rollWeighted() T {
 	var totalWeight float64
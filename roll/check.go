@@ -0,0 +1,266 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"math"
+)
+
+// Outcome is the category of a resolved check
+type Outcome int
+
+const (
+	// CriticalFailure is a fumble or a failure by the critical margin
+	CriticalFailure Outcome = iota
+
+	// Failure misses the target
+	Failure
+
+	// Success meets or beats the target
+	Success
+
+	// CriticalSuccess is a natural crit or a success by the critical margin
+	CriticalSuccess
+)
+
+// String returns the outcome name
+func (o Outcome) String() string {
+	switch o {
+	case CriticalFailure:
+		return "critical failure"
+	case Failure:
+		return "failure"
+	case Success:
+		return "success"
+	case CriticalSuccess:
+		return "critical success"
+	default:
+		return fmt.Sprintf("outcome(%d)", int(o))
+	}
+}
+
+// NaturalRule selects how natural crits and fumbles change an outcome
+type NaturalRule int
+
+const (
+	// NaturalOverride makes natural crits critical successes and fumbles critical failures
+	NaturalOverride NaturalRule = iota
+
+	// NaturalStep moves the outcome one step up on a natural crit and down on a fumble
+	NaturalStep
+)
+
+// FloatCheck is a check for float64 rolls
+type FloatCheck = Check[float64]
+
+// IntCheck is a check for int rolls
+type IntCheck = Check[int]
+
+// Check resolves rolls against a target number (a DC, or the successes a pool needs)
+type Check[T constraint] struct {
+	dc       T
+	modifier T
+
+	critAt     T
+	hasCrit    bool
+	fumbleAt   T
+	hasFumble  bool
+	naturals   NaturalRule
+	critMargin T
+	hasMargin  bool
+	degreeStep T
+	hasDegrees bool
+}
+
+// Resolution is the outcome of a check
+type Resolution[T constraint] struct {
+	// Outcome is the outcome category
+	Outcome Outcome
+
+	// Natural is the first roll before modifiers (0 for pools)
+	Natural T
+
+	// Total is the natural roll (or pool successes) plus the modifier
+	Total T
+
+	// Margin is Total minus the target, negative on a miss
+	Margin T
+
+	// Degrees is the margin in whole degree steps, rounded down (0 without Degrees)
+	Degrees int
+}
+
+// CheckOdds contains the exact probabilities of each outcome of a check
+type CheckOdds struct {
+	// Outcomes maps each outcome to its probability (0-100%)
+	Outcomes map[Outcome]float64
+
+	// Degrees maps each degree of success (negative for failures) to its probability (0-100%)
+	Degrees map[int]float64
+}
+
+// NewCheck creates a check against a target number
+// Totals at or above the target succeed
+func NewCheck[T constraint](dc T) *Check[T] {
+	return &Check[T]{dc: dc}
+}
+
+// Modifier sets the value added to the rolled total (automatic successes for pools)
+func (ch *Check[T]) Modifier(m T) *Check[T] {
+	ch.modifier = m
+	return ch
+}
+
+// CritOn sets natural rolls at or above v as crits (e.g. 20, or 19 for an expanded range)
+func (ch *Check[T]) CritOn(v T) *Check[T] {
+	ch.critAt, ch.hasCrit = v, true
+	return ch
+}
+
+// FumbleOn sets natural rolls at or below v as fumbles
+// Pools fumble on a botch
+func (ch *Check[T]) FumbleOn(v T) *Check[T] {
+	ch.fumbleAt, ch.hasFumble = v, true
+	return ch
+}
+
+// Naturals sets how natural crits and fumbles change the outcome (default NaturalOverride)
+func (ch *Check[T]) Naturals(rule NaturalRule) *Check[T] {
+	ch.naturals = rule
+	return ch
+}
+
+// CritMargin sets critical outcomes by margin: beating the target by m is a critical success,
+// missing it by m a critical failure (e.g. 10 for "beat the DC by 10")
+// Only hits can be critical successes and only misses critical failures, so with m = 0 every
+// hit is a critical success and every miss a critical failure
+func (ch *Check[T]) CritMargin(m T) *Check[T] {
+	ch.critMargin, ch.hasMargin = m, true
+	return ch
+}
+
+// Degrees sets the margin per degree of success or failure
+func (ch *Check[T]) Degrees(step T) *Check[T] {
+	if step <= 0 {
+		panic("check: degree step must be positive")
+	}
+	ch.degreeStep, ch.hasDegrees = step, true
+	return ch
+}
+
+// Resolve resolves a roll: the natural roll is First and the total is First plus the modifier
+// Explosions are not included, so resolved rolls follow Odds
+func (ch *Check[T]) Resolve(r Result[T]) Resolution[T] {
+	return ch.resolve(r.First, r.First+ch.modifier, ch.isCrit(r.First), ch.isFumble(r.First))
+}
+
+// ResolvePool resolves a success pool: the total is its successes plus the modifier
+// Pools never crit naturally, and fumble on a botch when FumbleOn is set
+func (ch *Check[T]) ResolvePool(r PoolResult[T]) Resolution[T] {
+	return ch.resolve(0, T(r.Successes)+ch.modifier, false, ch.hasFumble && r.Botch)
+}
+
+// Odds calculates the outcome probabilities of a single roll from the caster's odds
+// The total is the natural roll plus the modifier, as in Resolve
+// Float odds are evaluated at each bucket's lower bound
+func (ch *Check[T]) Odds(o Odds) CheckOdds {
+	odds := CheckOdds{Outcomes: make(map[Outcome]float64), Degrees: make(map[int]float64)}
+	for v, p := range o.Probabilities {
+		natural := T(v)
+		r := ch.resolve(natural, natural+ch.modifier, ch.isCrit(natural), ch.isFumble(natural))
+		odds.add(r.Outcome, r.Degrees, p)
+	}
+	return odds
+}
+
+// PoolOdds calculates the outcome probabilities of a success pool from its odds
+// Botches always show no successes, so they are split out of the zero-success case
+func (ch *Check[T]) PoolOdds(o PoolOdds) CheckOdds {
+	odds := CheckOdds{Outcomes: make(map[Outcome]float64), Degrees: make(map[int]float64)}
+	for n, p := range o.Successes {
+		total := T(n) + ch.modifier
+		if n == 0 && ch.hasFumble {
+			r := ch.resolve(0, total, false, true)
+			odds.add(r.Outcome, r.Degrees, o.Botch)
+			p -= o.Botch
+		}
+		r := ch.resolve(0, total, false, false)
+		odds.add(r.Outcome, r.Degrees, p)
+	}
+	return odds
+}
+
+// add accumulates the probability of an outcome and degree
+func (o *CheckOdds) add(outcome Outcome, degrees int, p float64) {
+	if p <= 0 {
+		return
+	}
+	o.Outcomes[outcome] += p
+	o.Degrees[degrees] += p
+}
+
+// resolve classifies a total, adjusting for natural crits and fumbles
+// Margins decide the outcome first, then naturals apply, a crit taking precedence over a fumble
+func (ch *Check[T]) resolve(natural, total T, crit, fumble bool) Resolution[T] {
+	margin := total - ch.dc
+	hit := margin >= 0
+	var outcome Outcome
+	switch {
+	case hit && ch.hasMargin && margin >= ch.critMargin:
+		outcome = CriticalSuccess
+	case hit:
+		outcome = Success
+	case ch.hasMargin && -margin >= ch.critMargin:
+		outcome = CriticalFailure
+	default:
+		outcome = Failure
+	}
+
+	switch {
+	case crit && ch.naturals == NaturalStep:
+		outcome = min(outcome+1, CriticalSuccess)
+	case crit:
+		outcome = CriticalSuccess
+	case fumble && ch.naturals == NaturalStep:
+		outcome = max(outcome-1, CriticalFailure)
+	case fumble:
+		outcome = CriticalFailure
+	}
+
+	var degrees int
+	if ch.hasDegrees {
+		degrees = int(math.Floor(float64(margin) / float64(ch.degreeStep)))
+	}
+	return Resolution[T]{Outcome: outcome, Natural: natural, Total: total, Margin: margin, Degrees: degrees}
+}
+
+// isCrit reports whether a natural roll is a crit
+func (ch *Check[T]) isCrit(natural T) bool {
+	return ch.hasCrit && natural >= ch.critAt
+}
+
+// isFumble reports whether a natural roll is a fumble
+func (ch *Check[T]) isFumble(natural T) bool {
+	return ch.hasFumble && natural <= ch.fumbleAt
+}
@@ -0,0 +1,127 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCheckResolve(t *testing.T) {
+	attack := NewCheck(15).Modifier(5).CritOn(20).FumbleOn(1)
+	pf2 := NewCheck(20).Modifier(7).CritMargin(10).Naturals(NaturalStep).CritOn(20).FumbleOn(1).Degrees(5)
+	zeroMargin := NewCheck(10).CritMargin(0)
+	both := NewCheck(10).CritOn(15).FumbleOn(15)
+
+	tests := []struct {
+		name    string
+		check   *IntCheck
+		natural int
+		want    Outcome
+		margin  int
+		degrees int
+	}{
+		{"hit", attack, 10, Success, 0, 0},
+		{"miss", attack, 9, Failure, -1, 0},
+		{"natural 20", attack, 20, CriticalSuccess, 10, 0},
+		{"natural 1", attack, 1, CriticalFailure, -9, 0},
+		{"step up", pf2, 20, CriticalSuccess, 7, 1},
+		{"step up from failure", NewCheck(30).Modifier(5).Naturals(NaturalStep).CritOn(20), 20, Success, -5, 0},
+		{"beat by 10", pf2, 19, Success, 6, 1},
+		{"step down", pf2, 1, CriticalFailure, -12, -3},
+		{"miss by 10", pf2, 3, CriticalFailure, -10, -2},
+		{"miss", pf2, 12, Failure, -1, -1},
+		{"meet", pf2, 13, Success, 0, 0},
+		{"zero margin meet", zeroMargin, 10, CriticalSuccess, 0, 0},
+		{"zero margin miss", zeroMargin, 9, CriticalFailure, -1, 0},
+		{"crit over fumble", both, 15, CriticalSuccess, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Sum includes explosions, which checks ignore
+			r := tt.check.Resolve(IntResult{First: tt.natural, Sum: tt.natural + 6})
+			if r.Outcome != tt.want || r.Margin != tt.margin || r.Degrees != tt.degrees || r.Natural != tt.natural {
+				t.Errorf("got %v margin %d degrees %d, want %v margin %d degrees %d",
+					r.Outcome, r.Margin, r.Degrees, tt.want, tt.margin, tt.degrees)
+			}
+		})
+	}
+}
+
+func TestCheckOdds(t *testing.T) {
+	caster := NewIntSource("test-seed").SaltDist("check")
+	odds := NewCheck(15).Modifier(5).CritOn(20).FumbleOn(1).Odds(caster.Odds(D20()))
+
+	want := map[Outcome]float64{CriticalFailure: 5, Failure: 40, Success: 50, CriticalSuccess: 5}
+	for o, p := range want {
+		if math.Abs(odds.Outcomes[o]-p) > 1e-9 {
+			t.Errorf("P(%v) = %v, want %v", o, odds.Outcomes[o], p)
+		}
+	}
+
+	// Resolving rolls agrees with the odds
+	check := NewCheck(25).Modifier(3).CritMargin(10).Naturals(NaturalStep).CritOn(19).FumbleOn(2).Degrees(10)
+	weighted := NewIntSource("test-seed").Dist(WeightedHigh()).Weight(0.5).Explode(ExplodeOnMax[int](2)).SaltDist("check")
+	odds = check.Odds(weighted.Odds(D20()))
+
+	const n = 100_000
+	counts := make(map[Outcome]int)
+	for _, r := range weighted.Multiple(n, D20()) {
+		counts[check.Resolve(r).Outcome]++
+	}
+	for o := CriticalFailure; o <= CriticalSuccess; o++ {
+		if got := float64(counts[o]) / n * 100; math.Abs(got-odds.Outcomes[o]) > 0.5 {
+			t.Errorf("P(%v) = %.2f%%, odds give %.2f%%", o, got, odds.Outcomes[o])
+		}
+	}
+}
+
+func TestCheckPool(t *testing.T) {
+	pool := NewIntSource("test-seed").SaltDist("pool check").Pool(5, D10()).Target(8).Botch(1).Cancel(true)
+	check := NewCheck(2).FumbleOn(0).CritMargin(2)
+	odds := check.PoolOdds(pool.Odds())
+
+	var total float64
+	for _, p := range odds.Outcomes {
+		total += p
+	}
+	if math.Abs(total-100) > 1e-9 {
+		t.Errorf("outcome odds sum to %v", total)
+	}
+
+	const n = 100_000
+	counts := make(map[Outcome]int)
+	for _, r := range pool.Multiple(n) {
+		res := check.ResolvePool(r)
+		if r.Botch && res.Outcome != CriticalFailure {
+			t.Fatalf("botch resolved as %v", res.Outcome)
+		}
+		counts[res.Outcome]++
+	}
+	for o := CriticalFailure; o <= CriticalSuccess; o++ {
+		if got := float64(counts[o]) / n * 100; math.Abs(got-odds.Outcomes[o]) > 0.5 {
+			t.Errorf("P(%v) = %.2f%%, odds give %.2f%%", o, got, odds.Outcomes[o])
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// mixture picks one of its distributions at random for each roll
+type mixture struct {
+	weights []float64
+	dists   []Distribution
+	total   float64
+}
+
+// Mixture combines distributions, each roll following dists[i] with probability weights[i]/sum(weights)
+// Panics if the lengths differ, a weight is negative or all weights are zero
+func Mixture(weights []float64, dists ...Distribution) Distribution {
+	if len(weights) != len(dists) || len(dists) == 0 {
+		panic(fmt.Sprintf("mixture: %d weights for %d distributions", len(weights), len(dists)))
+	}
+	var total float64
+	for _, w := range weights {
+		if w < 0 {
+			panic("mixture: negative weight")
+		}
+		total += w
+	}
+	if total == 0 {
+		panic("mixture: weights sum to zero")
+	}
+	return mixture{weights: slices.Clone(weights), dists: slices.Clone(dists), total: total}
+}
+
+// Rand generates a random value from a randomly picked component
+func (m mixture) Rand(p FloatParams) float64 {
+	u := p.Rng.Float64() * m.total
+	var cumulative float64
+	for i, w := range m.weights {
+		cumulative += w
+		if u < cumulative {
+			return m.dists[i].Rand(p)
+		}
+	}
+	return m.dists[len(m.dists)-1].Rand(p)
+}
+
+// CDF returns the weighted sum of the component CDFs
+func (m mixture) CDF(x float64, p FloatParams) float64 {
+	var sum float64
+	for i, w := range m.weights {
+		sum += float64(w * m.dists[i].CDF(x, p))
+	}
+	return sum / m.total
+}
+
+// Strict returns the mixture of the platform-independent variants
+func (m mixture) Strict() Distribution {
+	dists := make([]Distribution, len(m.dists))
+	for i, d := range m.dists {
+		dists[i] = strictOf(d)
+	}
+	return mixture{weights: m.weights, dists: dists, total: m.total}
+}
+
+// distName identifies the mixture by its weights and components
+func (m mixture) distName(weight float64) string {
+	parts := make([]string, len(m.dists))
+	for i, d := range m.dists {
+		parts[i] = fmt.Sprintf("%v:%s", m.weights[i], distName(d, weight))
+	}
+	return "mixture(" + strings.Join(parts, ",") + ")"
+}
+
+// mirror reflects a distribution around the middle of the range
+type mirror struct {
+	dist Distribution
+}
+
+// Mirror reflects the distribution, so a bias toward the minimum becomes a bias toward the maximum
+func Mirror(d Distribution) Distribution {
+	return mirror{dist: d}
+}
+
+// Rand generates a reflected random value
+func (m mirror) Rand(p FloatParams) float64 {
+	return max(p.Lower, p.Lower+p.Upper-m.dist.Rand(p))
+}
+
+// CDF returns the probability of a reflected value below x
+func (m mirror) CDF(x float64, p FloatParams) float64 {
+	return 1 - m.dist.CDF(p.Lower+p.Upper-x, p)
+}
+
+// Strict returns the mirror of the platform-independent variant
+func (m mirror) Strict() Distribution {
+	return mirror{dist: strictOf(m.dist)}
+}
+
+// distName identifies the mirror by its component
+func (m mirror) distName(weight float64) string {
+	return "mirror(" + distName(m.dist, weight) + ")"
+}
+
+// extreme is the maximum or minimum of n independent rolls
+type extreme struct {
+	dist    Distribution
+	n       int
+	highest bool
+}
+
+// MaxOf returns the distribution of the highest of n rolls, e.g. MaxOf(Uniform(), 2) for advantage
+// Panics if n < 1
+func MaxOf(d Distribution, n int) Distribution {
+	if n < 1 {
+		panic("max of: n < 1")
+	}
+	return extreme{dist: d, n: n, highest: true}
+}
+
+// MinOf returns the distribution of the lowest of n rolls, e.g. MinOf(Uniform(), 2) for disadvantage
+// Panics if n < 1
+func MinOf(d Distribution, n int) Distribution {
+	if n < 1 {
+		panic("min of: n < 1")
+	}
+	return extreme{dist: d, n: n, highest: false}
+}
+
+// Rand generates n values and keeps the highest or lowest
+func (e extreme) Rand(p FloatParams) float64 {
+	best := e.dist.Rand(p)
+	for i := 1; i < e.n; i++ {
+		v := e.dist.Rand(p)
+		if (e.highest && v > best) || (!e.highest && v < best) {
+			best = v
+		}
+	}
+	return best
+}
+
+// CDF returns F(x)^n for the maximum and 1-(1-F(x))^n for the minimum
+// Powers are repeated products so strict variants stay platform-independent
+func (e extreme) CDF(x float64, p FloatParams) float64 {
+	f := e.dist.CDF(x, p)
+	if !e.highest {
+		f = 1 - f
+	}
+	result := 1.0
+	for i := 0; i < e.n; i++ {
+		result *= f
+	}
+	if !e.highest {
+		return 1 - result
+	}
+	return result
+}
+
+// Strict returns the order statistic of the platform-independent variant
+func (e extreme) Strict() Distribution {
+	return extreme{dist: strictOf(e.dist), n: e.n, highest: e.highest}
+}
+
+// distName identifies the order statistic by its side, count and component
+func (e extreme) distName(weight float64) string {
+	side := "min"
+	if e.highest {
+		side = "max"
+	}
+	return fmt.Sprintf("%s(%d,%s)", side, e.n, distName(e.dist, weight))
+}
+
+// Truncate restricts the distribution to the normalized interval [lo, hi) of the range
+// Values outside it become impossible and the remaining probability is rescaled to 1
+// Panics unless 0 <= lo < hi <= 1
+func Truncate(d Distribution, lo, hi float64) Distribution {
+	if lo < 0 || hi > 1 || lo >= hi {
+		panic("truncate: want 0 <= lo < hi <= 1")
+	}
+	return warped{dist: d, lo: lo, hi: hi, scale: 1}
+}
+
+// Shift moves the distribution by offset, a fraction of the range width
+// Probability moved past either bound is dropped and the rest rescaled to 1
+func Shift(d Distribution, offset float64) Distribution {
+	return warped{dist: d, lo: 0, hi: 1, offset: offset, scale: 1}
+}
+
+// Stretch scales the distribution around the middle of the range by factor
+// A factor below 1 concentrates rolls toward the middle, above 1 spreads them out
+// Probability stretched past either bound is dropped and the rest rescaled to 1
+// Panics if factor <= 0
+func Stretch(d Distribution, factor float64) Distribution {
+	if factor <= 0 {
+		panic("stretch: factor <= 0")
+	}
+	return warped{dist: d, lo: 0, hi: 1, scale: factor}
+}
+
+// warped is a distribution moved and scaled in normalized space, then truncated to [lo, hi)
+// A normalized value y of the wrapped distribution maps to 0.5 + (y-0.5)*scale + offset
+type warped struct {
+	dist   Distribution
+	lo, hi float64
+	offset float64
+	scale  float64
+}
+
+// Rand generates a random value by inverting the CDF
+func (w warped) Rand(p FloatParams) float64 {
+	return quantile(w, p.Rng.Float64(), p)
+}
+
+// CDF returns the probability of a warped value below x, given it lies in [lo, hi)
+func (w warped) CDF(x float64, p FloatParams) float64 {
+	width := p.Upper - p.Lower
+	a := p.Lower + float64(w.lo*width)
+	b := p.Lower + float64(w.hi*width)
+	if x <= a {
+		return 0
+	}
+	if x >= b {
+		return 1
+	}
+
+	fa, fb := w.base(a, p), w.base(b, p)
+	if fb <= fa {
+		// No probability left in [lo, hi): fall back to uniform so the distribution stays valid
+		return (x - a) / (b - a)
+	}
+	return (w.base(x, p) - fa) / (fb - fa)
+}
+
+// distName identifies the warp by its interval, offset, scale and component
+func (w warped) distName(weight float64) string {
+	return fmt.Sprintf("warped(%v,%v,%v,%v,%s)", w.lo, w.hi, w.offset, w.scale, distName(w.dist, weight))
+}
+
+// base returns the CDF of the wrapped distribution at the point mapping to x
+func (w warped) base(x float64, p FloatParams) float64 {
+	width := p.Upper - p.Lower
+	mid := p.Lower + float64(0.5*width)
+	y := mid + (x-mid-float64(w.offset*width))/w.scale
+	if y <= p.Lower {
+		return 0
+	}
+	if y >= p.Upper {
+		return 1
+	}
+	return w.dist.CDF(y, p)
+}
+
+// Strict returns the warp of the platform-independent variant
+func (w warped) Strict() Distribution {
+	w.dist = strictOf(w.dist)
+	return w
+}
+
+// strictOf returns the platform-independent variant of the distribution, if it has one
+func strictOf(d Distribution) Distribution {
+	if s, ok := d.(StrictDistribution); ok {
+		return s.Strict()
+	}
+	return d
+}
@@ -0,0 +1,75 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCombinatorOdds(t *testing.T) {
+	odds := func(d Distribution) map[int]float64 {
+		return NewIntSource("test-seed").Dist(d).SaltDist("odds").Odds(D20()).Probabilities
+	}
+	near := func(got, want float64) bool { return math.Abs(got-want) < 1e-9 }
+
+	if p := odds(MaxOf(Uniform(), 2))[20]; !near(p, (1-0.95*0.95)*100) {
+		t.Errorf("advantage natural 20: got %.4f%%, want 9.75%%", p)
+	}
+	if p := odds(MinOf(Uniform(), 2))[20]; !near(p, 0.25) {
+		t.Errorf("disadvantage natural 20: got %.4f%%, want 0.25%%", p)
+	}
+
+	low, mirrored := odds(WeightedLow()), odds(Mirror(WeightedLow()))
+	for v := 1; v <= 20; v++ {
+		if !near(low[v], mirrored[21-v]) {
+			t.Errorf("mirror bucket %d: got %.4f%%, want %.4f%%", 21-v, mirrored[21-v], low[v])
+		}
+	}
+
+	truncated := odds(Truncate(Uniform(), 0.5, 1))
+	if !near(truncated[5], 0) || !near(truncated[15], 10) {
+		t.Errorf("truncating the lower half should double the upper odds, got %v", truncated)
+	}
+
+	mixed := odds(Mixture([]float64{1, 1}, Uniform(), Truncate(Uniform(), 0.95, 1)))
+	if !near(mixed[20], 52.5) {
+		t.Errorf("mixture natural 20: got %.4f%%, want 52.5%%", mixed[20])
+	}
+}
+
+func TestCombinatorsStrict(t *testing.T) {
+	d := MaxOf(Mirror(Normal()), 2)
+	if _, ok := d.(StrictDistribution); !ok {
+		t.Fatal("combinators should have strict variants")
+	}
+
+	src := NewIntSource("test-seed").Dist(d).Weight(0.3)
+	want := src.SaltDist("odds").Odds(D20()).Probabilities
+	got := src.Strict(true).SaltDist("odds").Odds(D20()).Probabilities
+	for v := 1; v <= 20; v++ {
+		if math.Abs(got[v]-want[v]) > 1e-6 {
+			t.Errorf("strict bucket %d: got %.8f%%, want %.8f%%", v, got[v], want[v])
+		}
+	}
+}
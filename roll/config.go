@@ -24,12 +24,14 @@ package roll
 
 import "slices"
 
-// config holds the base configuration shared by all casters (explosion settings)
+// config holds the base configuration shared by all casters (reroll and explosion settings)
 type config[T constraint] struct {
 	RerollBelow        T
 	MaxLowerExplosions int
 	RerollAbove        T
 	MaxUpperExplosions int
+	Explosions         []Explosion[T]
+	Rerolls            []Reroll[T]
 }
 
 // shouldExplodeLower returns true if the roll should trigger a lower explosion
@@ -45,8 +47,10 @@ func (c *config[T]) shouldExplodeUpper(rollValue T, rollCount int) bool {
 // distConfig holds configuration for distribution-based rolling
 type distConfig[T constraint] struct {
 	config[T]
-	Dist   Distribution
-	Weight float64
+	Dist        Distribution
+	Weight      float64
+	Correlation float64
+	Strict      bool
 }
 
 // distConfigFromOptions extracts a distConfig from Options
@@ -57,12 +61,25 @@ func distConfigFromOptions[T constraint](opts Options[T]) distConfig[T] {
 			MaxLowerExplosions: opts.MaxLowerExplosions,
 			RerollAbove:        opts.RerollAbove,
 			MaxUpperExplosions: opts.MaxUpperExplosions,
+			Explosions:         opts.Explosions,
+			Rerolls:            opts.Rerolls,
 		},
-		Dist:   opts.Dist,
-		Weight: opts.Weight,
+		Dist:        opts.Dist,
+		Weight:      opts.Weight,
+		Correlation: opts.Correlation,
+		Strict:      opts.Strict,
 	}
 }
 
+// distribution returns the distribution to roll with
+// Strict configs switch to the platform-independent variant when the distribution has one
+func (c *distConfig[T]) distribution() Distribution {
+	if c.Strict {
+		return strictOf(c.Dist)
+	}
+	return c.Dist
+}
+
 // weightedConfig holds configuration for custom weight-based rolling
 type weightedConfig[T constraint] struct {
 	config[T]
@@ -77,6 +94,8 @@ func weightedConfigFromOptions[T constraint](opts Options[T]) weightedConfig[T]
 			MaxLowerExplosions: opts.MaxLowerExplosions,
 			RerollAbove:        opts.RerollAbove,
 			MaxUpperExplosions: opts.MaxUpperExplosions,
+			Explosions:         opts.Explosions,
+			Rerolls:            opts.Rerolls,
 		},
 		tickets: ticketsFromWeights(opts.Custom),
 	}
@@ -0,0 +1,448 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+// maxTieRerolls bounds the rerolls of a TieReroll contest, after which the tie stands
+const maxTieRerolls = 100
+
+// maxRerollContestants bounds the contestants of exact TieReroll odds, which visit every subset
+const maxRerollContestants = 12
+
+// TieBreak selects how a contest resolves a tie for the highest total
+type TieBreak int
+
+const (
+	// TieStands leaves a tie without a winner
+	TieStands TieBreak = iota
+
+	// TieFirst awards a tie to the earliest tied contestant (e.g. the attacker)
+	TieFirst
+
+	// TieLast awards a tie to the latest tied contestant (e.g. the defender)
+	TieLast
+
+	// TieReroll has the tied contestants roll again until one of them wins
+	TieReroll
+)
+
+// String returns the tie-break name
+func (t TieBreak) String() string {
+	switch t {
+	case TieStands:
+		return "stands"
+	case TieFirst:
+		return "first"
+	case TieLast:
+		return "last"
+	case TieReroll:
+		return "reroll"
+	default:
+		return fmt.Sprintf("tiebreak(%d)", int(t))
+	}
+}
+
+// Contestant is a caster rolling on a range in a contest
+type Contestant[T constraint] struct {
+	// Caster rolls the contestant's value
+	Caster Caster[T]
+
+	// Range is the range rolled
+	Range Range[T]
+
+	// Modifier is added to the first roll
+	Modifier T
+}
+
+// Contest rolls contestants against each other, the highest total winning
+type Contest[T constraint] struct {
+	contestants []Contestant[T]
+	tie         TieBreak
+}
+
+// ContestResult is the outcome of a contest
+type ContestResult[T constraint] struct {
+	// Results holds each contestant's first roll
+	Results []Result[T]
+
+	// Totals holds each contestant's first roll plus modifier, ignoring explosions
+	Totals []T
+
+	// Winner is the index of the winning contestant, or -1 if the tie stands
+	Winner int
+
+	// Tied holds the contestants tied for the highest total on the first roll
+	Tied []int
+
+	// Margin is the highest total minus the runner-up total on the first roll (0 on a tie)
+	Margin T
+
+	// Rerolls is the number of rerolls made to break a tie
+	Rerolls int
+}
+
+// ContestOdds contains the exact outcome probabilities of a contest
+type ContestOdds struct {
+	// Wins holds each contestant's probability of winning (0-100%)
+	Wins []float64
+
+	// Tie is the probability that no contestant wins (0-100%)
+	Tie float64
+
+	// Margins maps the highest total minus the runner-up total on the first roll to its probability (0-100%)
+	Margins map[int]float64
+}
+
+// VersusOdds contains the exact outcome probabilities of one roll against another
+type VersusOdds struct {
+	// Win is the probability that the first roll is higher (0-100%)
+	Win float64
+
+	// Tie is the probability that the rolls are equal (0-100%)
+	Tie float64
+
+	// Loss is the probability that the second roll is higher (0-100%)
+	Loss float64
+
+	// Margins maps the first roll minus the second to its probability (0-100%)
+	Margins map[int]float64
+}
+
+// NewContest creates a contest between at least two contestants, ties standing by default
+func NewContest[T constraint](contestants ...Contestant[T]) *Contest[T] {
+	if len(contestants) < 2 {
+		panic("contest: need at least two contestants")
+	}
+	return &Contest[T]{contestants: slices.Clone(contestants)}
+}
+
+// TieBreak sets how ties for the highest total are resolved
+func (c *Contest[T]) TieBreak(t TieBreak) *Contest[T] {
+	c.tie = t
+	return c
+}
+
+// Roll rolls every contestant once (and the tied ones again for TieReroll)
+// Contestants are compared on their first roll plus modifier, as in Check.Resolve, so explosions do not count
+// TieReroll stops after 100 rerolls, after which the tie stands
+func (c *Contest[T]) Roll() ContestResult[T] {
+	result := ContestResult[T]{
+		Results: make([]Result[T], len(c.contestants)),
+		Totals:  make([]T, len(c.contestants)),
+		Winner:  -1,
+	}
+	all := make([]int, len(c.contestants))
+	for i := range c.contestants {
+		all[i] = i
+		result.Results[i] = c.contestants[i].Caster.One(c.contestants[i].Range)
+		result.Totals[i] = result.Results[i].First + c.contestants[i].Modifier
+	}
+
+	leaders := leadersOf(result.Totals, all)
+	if len(leaders) == 1 {
+		result.Margin = result.Totals[leaders[0]] - runnerUp(result.Totals, leaders[0])
+		result.Winner = leaders[0]
+		return result
+	}
+	result.Tied = leaders
+
+	switch c.tie {
+	case TieFirst:
+		result.Winner = leaders[0]
+	case TieLast:
+		result.Winner = leaders[len(leaders)-1]
+	case TieReroll:
+		totals := make([]T, len(c.contestants))
+		for len(leaders) > 1 && result.Rerolls < maxTieRerolls {
+			for _, i := range leaders {
+				totals[i] = c.contestants[i].Caster.One(c.contestants[i].Range).First + c.contestants[i].Modifier
+			}
+			leaders = leadersOf(totals, leaders)
+			result.Rerolls++
+		}
+		if len(leaders) == 1 {
+			result.Winner = leaders[0]
+		}
+	}
+	return result
+}
+
+// Multiple rolls the contest count times
+func (c *Contest[T]) Multiple(count int) []ContestResult[T] {
+	results := make([]ContestResult[T], count)
+	for i := range results {
+		results[i] = c.Roll()
+	}
+	return results
+}
+
+// Odds calculates the exact outcome probabilities from each contestant's odds
+// Totals are taken as the first roll plus the modifier, matching Roll, so explosions are not included
+// Odds are computed per integer bucket, so a fractional float modifier is truncated toward zero
+// TieReroll odds assume unlimited rerolls, which differs from Roll only by the chance of 100 ties in a row,
+// and support up to 12 contestants
+func (c *Contest[T]) Odds() ContestOdds {
+	pmfs := make([]map[int]float64, len(c.contestants))
+	for i, ct := range c.contestants {
+		pmfs[i] = make(map[int]float64)
+		for v, p := range ct.Caster.Odds(ct.Range).Probabilities {
+			pmfs[i][v+int(ct.Modifier)] += p / 100
+		}
+	}
+	t := newContestTable(pmfs)
+
+	odds := ContestOdds{Wins: make([]float64, len(pmfs)), Margins: t.margins()}
+	switch c.tie {
+	case TieReroll:
+		if len(pmfs) > maxRerollContestants {
+			panic("contest: too many contestants for exact reroll odds")
+		}
+		wins := t.rerollWins(uint(1)<<len(pmfs)-1, make(map[uint][]float64))
+		for i := range odds.Wins {
+			odds.Wins[i] = wins[i] * 100
+		}
+	default:
+		all := make([]int, len(pmfs))
+		for i := range all {
+			all[i] = i
+		}
+		for i := range odds.Wins {
+			odds.Wins[i] = t.wins(i, all, c.tie) * 100
+		}
+	}
+
+	odds.Tie = 100
+	for _, w := range odds.Wins {
+		odds.Tie -= w
+	}
+	odds.Tie = max(odds.Tie, 0)
+	return odds
+}
+
+// Versus combines the odds of two rolls into exact win, tie and loss probabilities
+// and the distribution of the margin, whatever distribution each roll uses
+func Versus(a, b Odds) VersusOdds {
+	odds := VersusOdds{Margins: make(map[int]float64)}
+	for x, p := range a.Probabilities {
+		for y, q := range b.Probabilities {
+			pq := p * q / 100
+			odds.Margins[x-y] += pq
+			switch {
+			case x > y:
+				odds.Win += pq
+			case x < y:
+				odds.Loss += pq
+			default:
+				odds.Tie += pq
+			}
+		}
+	}
+	return odds
+}
+
+// leadersOf returns the candidates with the highest total, in order
+func leadersOf[T constraint](totals []T, candidates []int) []int {
+	var leaders []int
+	for _, i := range candidates {
+		switch {
+		case len(leaders) == 0 || totals[i] > totals[leaders[0]]:
+			leaders = append(leaders[:0], i)
+		case totals[i] == totals[leaders[0]]:
+			leaders = append(leaders, i)
+		}
+	}
+	return leaders
+}
+
+// runnerUp returns the highest total of everyone but the leader
+func runnerUp[T constraint](totals []T, leader int) T {
+	var best T
+	first := true
+	for i, v := range totals {
+		if i != leader && (first || v > best) {
+			best, first = v, false
+		}
+	}
+	return best
+}
+
+// contestTable holds each contestant's probabilities over the sorted union of totals
+type contestTable struct {
+	values []int
+
+	// p, below and atMost hold P(X = v), P(X < v) and P(X <= v) per contestant and value
+	p, below, atMost [][]float64
+}
+
+// newContestTable tabulates the contestants' probabilities
+func newContestTable(pmfs []map[int]float64) *contestTable {
+	var values []int
+	for _, pmf := range pmfs {
+		for v := range pmf {
+			values = append(values, v)
+		}
+	}
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	t := &contestTable{values: values}
+	for _, pmf := range pmfs {
+		p := make([]float64, len(values))
+		below := make([]float64, len(values))
+		atMost := make([]float64, len(values))
+		var cumulative float64
+		for k, v := range values {
+			p[k] = pmf[v]
+			below[k] = cumulative
+			cumulative += p[k]
+			atMost[k] = cumulative
+		}
+		t.p = append(t.p, p)
+		t.below = append(t.below, below)
+		t.atMost = append(t.atMost, atMost)
+	}
+	return t
+}
+
+// wins returns the probability that contestant i wins outright or by the tie-break among members
+func (t *contestTable) wins(i int, members []int, tie TieBreak) float64 {
+	var sum float64
+	for k := range t.values {
+		q := t.p[i][k]
+		for _, j := range members {
+			if j == i {
+				continue
+			}
+			// Contestants the tie-break favors must be strictly below, the others at most equal
+			if tie == TieFirst && j > i || tie == TieLast && j < i {
+				q *= t.atMost[j][k]
+			} else {
+				q *= t.below[j][k]
+			}
+		}
+		sum += q
+	}
+	return sum
+}
+
+// tieSet returns the probability that exactly the contestants in tied share the highest total of set
+func (t *contestTable) tieSet(tied, set uint) float64 {
+	var sum float64
+	for k := range t.values {
+		q := 1.0
+		for j := range t.p {
+			switch {
+			case tied&(1<<j) != 0:
+				q *= t.p[j][k]
+			case set&(1<<j) != 0:
+				q *= t.below[j][k]
+			}
+		}
+		sum += q
+	}
+	return sum
+}
+
+// rerollWins returns each contestant's chance of winning when set rolls and ties reroll
+// A tie among a subset recurses into that subset, and a tie of the whole set repeats
+// the roll, which the geometric series resolves by dividing by its complement
+func (t *contestTable) rerollWins(set uint, memo map[uint][]float64) []float64 {
+	if w, ok := memo[set]; ok {
+		return w
+	}
+	wins := make([]float64, len(t.p))
+	if bits.OnesCount(set) == 1 {
+		wins[bits.TrailingZeros(set)] = 1
+		memo[set] = wins
+		return wins
+	}
+
+	var members []int
+	for i := range wins {
+		if set&(1<<i) != 0 {
+			members = append(members, i)
+		}
+	}
+	for _, i := range members {
+		wins[i] = t.wins(i, members, TieStands)
+	}
+	for sub := (set - 1) & set; sub > 0; sub = (sub - 1) & set {
+		if bits.OnesCount(sub) < 2 {
+			continue
+		}
+		q := t.tieSet(sub, set)
+		if q == 0 {
+			continue
+		}
+		for i, w := range t.rerollWins(sub, memo) {
+			wins[i] += q * w
+		}
+	}
+
+	// A certain tie never resolves and stands
+	if again := 1 - t.tieSet(set, set); again > 0 {
+		for i := range wins {
+			wins[i] /= again
+		}
+	} else {
+		clear(wins)
+	}
+	memo[set] = wins
+	return wins
+}
+
+// margins returns the distribution of the highest total minus the runner-up total
+func (t *contestTable) margins() map[int]float64 {
+	margins := make(map[int]float64)
+	var unique float64
+	for i := range t.p {
+		for k, v := range t.values {
+			if t.p[i][k] == 0 {
+				continue
+			}
+			// The runner-up is u when everyone else is at most u, but not all below u
+			for l := 0; l < k; l++ {
+				atMost, below := 1.0, 1.0
+				for j := range t.p {
+					if j != i {
+						atMost *= t.atMost[j][l]
+						below *= t.below[j][l]
+					}
+				}
+				if q := t.p[i][k] * (atMost - below); q > 0 {
+					margins[v-t.values[l]] += q * 100
+					unique += q
+				}
+			}
+		}
+	}
+	if tie := 1 - unique; tie > 1e-12 {
+		margins[0] = tie * 100
+	}
+	return margins
+}
@@ -0,0 +1,163 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVersus(t *testing.T) {
+	src := NewIntSource("test-seed")
+	odds := Versus(src.SaltDist("a").Odds(D6()), src.SaltDist("b").Odds(D6()))
+
+	if math.Abs(odds.Win-100.0*15/36) > 1e-9 || math.Abs(odds.Tie-100.0/6) > 1e-9 || math.Abs(odds.Loss-odds.Win) > 1e-9 {
+		t.Errorf("d6 vs d6: win %v tie %v loss %v", odds.Win, odds.Tie, odds.Loss)
+	}
+	for m := 1; m <= 5; m++ {
+		want := 100.0 * float64(6-m) / 36
+		if math.Abs(odds.Margins[m]-want) > 1e-9 || math.Abs(odds.Margins[-m]-want) > 1e-9 {
+			t.Errorf("P(margin ±%d) = %v/%v, want %v", m, odds.Margins[m], odds.Margins[-m], want)
+		}
+	}
+}
+
+func TestContestTwoSided(t *testing.T) {
+	src := NewIntSource("test-seed")
+	attacker := Contestant[int]{Caster: src.Derive().Dist(WeightedHigh()).Weight(0.4).SaltDist("a"), Range: D20()}
+	defender := Contestant[int]{Caster: src.SaltDist("d"), Range: D20(), Modifier: 2}
+
+	versus := Versus(attacker.Caster.Odds(D20()), NewIntSource("test-seed").SaltDist("d").Odds(Range[int]{Lower: 3, Upper: 22}))
+	tests := []struct {
+		tie  TieBreak
+		wins float64
+		tied float64
+	}{
+		{TieStands, versus.Win, versus.Tie},
+		{TieFirst, versus.Win + versus.Tie, 0},
+		{TieLast, versus.Win, 0},
+		{TieReroll, versus.Win / (versus.Win + versus.Loss) * 100, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tie.String(), func(t *testing.T) {
+			odds := NewContest(attacker, defender).TieBreak(tt.tie).Odds()
+			if math.Abs(odds.Wins[0]-tt.wins) > 1e-9 || math.Abs(odds.Tie-tt.tied) > 1e-9 {
+				t.Errorf("attacker wins %v tie %v, want %v and %v", odds.Wins[0], odds.Tie, tt.wins, tt.tied)
+			}
+		})
+	}
+}
+
+func TestContestManyContestants(t *testing.T) {
+	contestants := make([]Contestant[int], 70)
+	for i := range contestants {
+		contestants[i] = Contestant[int]{Caster: NewIntSource("test-seed").SaltDist("crowd"), Range: D20()}
+	}
+
+	odds := NewContest(contestants...).TieBreak(TieFirst).Odds()
+	var total float64
+	for _, w := range odds.Wins {
+		total += w
+	}
+	if math.Abs(total-100) > 1e-9 || odds.Wins[69] <= 0 || odds.Wins[0] <= odds.Wins[69] {
+		t.Errorf("every contestant should count: wins sum to %v, first %v, last %v", total, odds.Wins[0], odds.Wins[69])
+	}
+}
+
+func TestContestMatchesOdds(t *testing.T) {
+	src := NewIntSource("test-seed")
+	contestants := []Contestant[int]{
+		{Caster: src.Derive().Dist(Normal()).Weight(0.5).SaltDist("a"), Range: D10(), Modifier: 2},
+		{Caster: src.Derive().Dist(WeightedLow()).Weight(0.3).SaltDist("b"), Range: Dice(12)},
+		{Caster: src.SaltCustomWeighted("c", IntWeights{4: 1, 6: 2, 8: 1}), Range: D8()},
+	}
+
+	const n = 100_000
+	for _, tie := range []TieBreak{TieStands, TieFirst, TieLast, TieReroll} {
+		t.Run(tie.String(), func(t *testing.T) {
+			contest := NewContest(contestants...).TieBreak(tie)
+			wins := make([]int, len(contestants))
+			margins := make(map[int]int)
+			var ties int
+			for _, r := range contest.Multiple(n) {
+				if r.Winner < 0 {
+					ties++
+				} else {
+					wins[r.Winner]++
+				}
+				margins[r.Margin]++
+			}
+
+			odds := contest.Odds()
+			for i, w := range wins {
+				if got := float64(w) / n * 100; math.Abs(got-odds.Wins[i]) > 0.5 {
+					t.Errorf("P(contestant %d wins) = %.2f%%, odds give %.2f%%", i, got, odds.Wins[i])
+				}
+			}
+			if got := float64(ties) / n * 100; math.Abs(got-odds.Tie) > 0.5 {
+				t.Errorf("P(tie) = %.2f%%, odds give %.2f%%", got, odds.Tie)
+			}
+			var total float64
+			for m, p := range odds.Margins {
+				total += p
+				if got := float64(margins[m]) / n * 100; math.Abs(got-p) > 0.5 {
+					t.Errorf("P(margin %d) = %.2f%%, odds give %.2f%%", m, got, p)
+				}
+			}
+			if math.Abs(total-100) > 1e-9 {
+				t.Errorf("margins sum to %v", total)
+			}
+		})
+	}
+}
+
+func TestContestIgnoresExplosions(t *testing.T) {
+	src := NewIntSource("test-seed")
+	contestants := []Contestant[int]{
+		{Caster: src.Derive().Explode(ExplodeOnMax[int](3)).SaltDist("a"), Range: D6()},
+		{Caster: src.SaltDist("b"), Range: D6(), Modifier: 1},
+	}
+
+	const n = 100_000
+	for _, tie := range []TieBreak{TieStands, TieReroll} {
+		t.Run(tie.String(), func(t *testing.T) {
+			contest := NewContest(contestants...).TieBreak(tie)
+			var wins, exploded int
+			for _, r := range contest.Multiple(n) {
+				if r.Winner == 0 {
+					wins++
+				}
+				if len(r.Results[0].Extras) > 0 {
+					exploded++
+				}
+			}
+			if exploded == 0 {
+				t.Fatal("caster never exploded")
+			}
+			if got, want := float64(wins)/n*100, contest.Odds().Wins[0]; math.Abs(got-want) > 0.5 {
+				t.Errorf("P(exploding contestant wins) = %.2f%%, odds give %.2f%%", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+
+	"lukechampine.com/blake3"
+	"lukechampine.com/blake3/guts"
+)
+
+// deriveContext is the BLAKE3 context string for hierarchical derivation
+const deriveContext = "github.com/andrei-cosmin/dixe 2025 hierarchical derivation"
+
+// maxChunkWords is the number of 64-bit words that fit in a single BLAKE3 chunk
+const maxChunkWords = guts.ChunkSize / 8
+
+// hashKey is a BLAKE3 key kept in both byte and word form
+type hashKey struct {
+	bytes [32]byte
+	words [8]uint32
+}
+
+// newHashKey prepares a BLAKE3 key for keyed hashing
+func newHashKey(key [32]byte) hashKey {
+	k := hashKey{bytes: key}
+	for i := range k.words {
+		k.words[i] = binary.LittleEndian.Uint32(key[i*4:])
+	}
+	return k
+}
+
+// saltKey derives the 32-byte key for a salt from the source seed, per the source version
+func (s *Source[T]) saltKey(salt string) [32]byte {
+	var key [32]byte
+	switch s.version {
+	case V2, V3:
+		material := binary.AppendUvarint(nil, uint64(len(s.seed)))
+		material = append(material, s.seed...)
+		material = binary.AppendUvarint(material, uint64(len(salt)))
+		material = append(material, salt...)
+		blake3.DeriveKey(key[:], v2SaltContext, material)
+	default:
+		blake3.DeriveKey(key[:], s.seed, []byte(salt))
+	}
+	return key
+}
+
+// derivePath derives a 32-byte child key by walking the path one segment at a time
+// Each step hashes the length-prefixed parent key and segment, so ("a", "bc") and ("ab", "c")
+// never collide, and deriving ("a", "b") equals deriving "a" then "b"
+func derivePath(key []byte, path []string) [32]byte {
+	var child [32]byte
+	copy(child[:], key)
+	for _, segment := range path {
+		material := binary.AppendUvarint(nil, uint64(len(key)))
+		material = append(material, key...)
+		material = binary.AppendUvarint(material, uint64(len(segment)))
+		material = append(material, segment...)
+		blake3.DeriveKey(child[:], deriveContext, material)
+		key = child[:]
+	}
+	return child
+}
+
+// label derives an independent key for one use of this key, e.g. a single Field output
+func (k *hashKey) label(name string) hashKey {
+	return newHashKey(derivePath(k.bytes[:], []string{name}))
+}
+
+// sum computes the keyed BLAKE3 hash of the little-endian encoded words
+// Inputs that fit in one chunk are compressed directly, without allocating a hasher
+func (k *hashKey) sum(words []int64) [64]byte {
+	if len(words) > maxChunkWords {
+		h := blake3.New(64, k.bytes[:])
+		var buf [8]byte
+		for _, w := range words {
+			binary.LittleEndian.PutUint64(buf[:], uint64(w))
+			h.Write(buf[:])
+		}
+		var out [64]byte
+		h.Sum(out[:0])
+		return out
+	}
+
+	var buf [guts.ChunkSize]byte
+	for i, w := range words {
+		binary.LittleEndian.PutUint64(buf[i*8:], uint64(w))
+	}
+	n := guts.CompressChunk(buf[:len(words)*8], &k.words, 0, guts.FlagKeyedHash)
+	n.Flags |= guts.FlagRoot
+	return guts.WordsToBytes(guts.CompressNode(n))
+}
+
+// uint64 returns the first 64 bits of the keyed hash of the words
+func (k *hashKey) uint64(words []int64) uint64 {
+	out := k.sum(words)
+	return binary.LittleEndian.Uint64(out[:8])
+}
+
+// derive returns a PCG source seeded by the keyed hash of the words,
+// together with a child key taken from the upper half of the same hash
+func (k *hashKey) derive(words []int64) (*rand.PCG, hashKey) {
+	out := k.sum(words)
+	src := rand.NewPCG(
+		binary.LittleEndian.Uint64(out[0:8]),
+		binary.LittleEndian.Uint64(out[8:16]),
+	)
+	return src, newHashKey([32]byte(out[32:]))
+}
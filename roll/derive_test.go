@@ -0,0 +1,78 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import "testing"
+
+func TestDeriveUnambiguous(t *testing.T) {
+	src := NewIntSource("test-seed")
+
+	if src.Derive("a", "bc").seed == src.Derive("ab", "c").seed {
+		t.Error("length-prefixed paths should not collide")
+	}
+
+	if src.Derive("a", "b").seed != src.Derive("a").Derive("b").seed {
+		t.Error("deriving a path should equal deriving each segment in turn")
+	}
+	if src.Derive().seed != src.seed {
+		t.Error("an empty path should keep the parent seed")
+	}
+
+	c := src.SaltDist("salt")
+	if c.Derive("a", "b").key != c.Derive("a").Derive("b").key {
+		t.Error("caster derivation should compose like source derivation")
+	}
+	if c.Derive("x").One(D100()).First != src.SaltDist("salt").Derive("x").One(D100()).First {
+		t.Error("caster derivation should not depend on the parent stream position")
+	}
+}
+
+func TestDeriveInheritsOptions(t *testing.T) {
+	parent := NewIntSource("test-seed").Dist(Normal()).Weight(0.3).Custom(IntWeights{1: 1, 2: 3})
+	child := parent.Derive("loot").Weight(0.9)
+	child.opts.Custom[5] = 1
+
+	if child.opts.Dist != parent.opts.Dist {
+		t.Error("child should inherit the distribution")
+	}
+	if parent.opts.Weight != 0.3 || len(parent.opts.Custom) != 2 {
+		t.Error("configuring the child should not change the parent")
+	}
+}
+
+func TestCasterDeriveEmptyPath(t *testing.T) {
+	src := NewIntSource("test-seed")
+	for name, derive := range map[string]func(){
+		"dist":     func() { src.SaltDist("salt").Derive() },
+		"weighted": func() { src.SaltCustomWeighted("salt", IntWeights{1: 1}).Derive() },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("deriving an empty path should panic")
+				}
+			}()
+			derive()
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DigitDie reads several dice as the digits of one number, most significant first
+// (d66, d666, or d100 rolled as a tens die and a ones die)
+type DigitDie struct {
+	digits []IntRange
+	zero   int
+}
+
+// DigitResult is the outcome of a digit die roll
+type DigitResult struct {
+	// Value is the number read from the digits
+	Value int
+
+	// Digits holds the face of each die, most significant first
+	Digits []int
+}
+
+// NewDigitDie creates a digit die with one die per digit, each with faces within 0-9
+func NewDigitDie(digits ...IntRange) *DigitDie {
+	if len(digits) == 0 {
+		panic("digits: need at least one digit")
+	}
+	for _, d := range digits {
+		if d.Lower < 0 || d.Upper > 9 || d.Lower > d.Upper {
+			panic(fmt.Sprintf("digits: digit range %d-%d is not within 0-9", d.Lower, d.Upper))
+		}
+	}
+	return &DigitDie{digits: slices.Clone(digits)}
+}
+
+// D66 returns two d6 read as tens and ones (11-66)
+func D66() *DigitDie {
+	return NewDigitDie(D6(), D6())
+}
+
+// D666 returns three d6 read as hundreds, tens and ones (111-666)
+func D666() *DigitDie {
+	return NewDigitDie(D6(), D6(), D6())
+}
+
+// Percentile returns a tens die and a ones die with faces 0-9, where 00 reads as 100 (1-100)
+func Percentile() *DigitDie {
+	return NewDigitDie(IntRange{Lower: 0, Upper: 9}, IntRange{Lower: 0, Upper: 9}).ZeroAs(100)
+}
+
+// ZeroAs sets the value read when every digit shows 0
+func (d *DigitDie) ZeroAs(v int) *DigitDie {
+	d.zero = v
+	return d
+}
+
+// Digits returns the number of digits
+func (d *DigitDie) Digits() int {
+	return len(d.digits)
+}
+
+// Keys returns every value the die can read, in ascending order
+func (d *DigitDie) Keys() []int {
+	keys := []int{0}
+	for _, r := range d.digits {
+		next := make([]int, 0, len(keys)*(r.Upper-r.Lower+1))
+		for _, k := range keys {
+			for v := r.Lower; v <= r.Upper; v++ {
+				next = append(next, k*10+v)
+			}
+		}
+		keys = next
+	}
+	for i, k := range keys {
+		keys[i] = d.read(k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Roll rolls each digit, with one caster for every digit or one caster per digit
+// Per-digit casters model physical dice with their own bias
+func (d *DigitDie) Roll(casters ...Caster[int]) DigitResult {
+	result := DigitResult{Digits: make([]int, len(d.digits))}
+	var value int
+	for i, r := range d.digits {
+		result.Digits[i] = d.caster(casters, i).One(r).First
+		value = value*10 + result.Digits[i]
+	}
+	result.Value = d.read(value)
+	return result
+}
+
+// Odds calculates the probability of each value from each digit's caster odds (0-100%)
+func (d *DigitDie) Odds(casters ...Caster[int]) map[int]float64 {
+	odds := map[int]float64{0: 1}
+	for i, r := range d.digits {
+		faces := d.caster(casters, i).Odds(r).Probabilities
+		next := make(map[int]float64, len(odds)*len(faces))
+		for k, p := range odds {
+			for v := r.Lower; v <= r.Upper; v++ {
+				next[k*10+v] += p * faces[v] / 100
+			}
+		}
+		odds = next
+	}
+
+	values := make(map[int]float64, len(odds))
+	for k, p := range odds {
+		values[d.read(k)] += p * 100
+	}
+	return values
+}
+
+// Result returns the roll as a single-value Result
+func (r DigitResult) Result() Result[int] {
+	return Result[int]{First: r.Value, Last: r.Value, Sum: r.Value, Rolls: []int{r.Value}}
+}
+
+// read maps the digits read as a number to the die value
+func (d *DigitDie) read(v int) int {
+	if v == 0 && d.zero != 0 {
+		return d.zero
+	}
+	return v
+}
+
+// caster returns the caster rolling digit i
+func (d *DigitDie) caster(casters []Caster[int], i int) Caster[int] {
+	switch len(casters) {
+	case 1:
+		return casters[0]
+	case len(d.digits):
+		return casters[i]
+	default:
+		panic(fmt.Sprintf("digits: need 1 or %d casters, got %d", len(d.digits), len(casters)))
+	}
+}
@@ -0,0 +1,87 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDigitDieOdds(t *testing.T) {
+	c := NewIntSource("test-seed").SaltDist("digits")
+
+	tests := []struct {
+		name  string
+		die   *DigitDie
+		lower int
+		upper int
+		keys  int
+	}{
+		{"d66", D66(), 11, 66, 36},
+		{"d666", D666(), 111, 666, 216},
+		{"percentile", Percentile(), 1, 100, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := tt.die.Keys()
+			if len(keys) != tt.keys || keys[0] != tt.lower || keys[len(keys)-1] != tt.upper {
+				t.Fatalf("keys %d from %d to %d, want %d from %d to %d",
+					len(keys), keys[0], keys[len(keys)-1], tt.keys, tt.lower, tt.upper)
+			}
+			odds := tt.die.Odds(c)
+			for _, k := range keys {
+				if math.Abs(odds[k]-100/float64(tt.keys)) > 1e-9 {
+					t.Errorf("P(%d) = %v, want %v", k, odds[k], 100/float64(tt.keys))
+				}
+			}
+			for i := 0; i < 1000; i++ {
+				if v := tt.die.Roll(c).Value; v < tt.lower || v > tt.upper || odds[v] == 0 {
+					t.Fatalf("rolled %d, not a key", v)
+				}
+			}
+		})
+	}
+}
+
+func TestDigitDieBias(t *testing.T) {
+	tens := NewIntSource("test-seed").Dist(WeightedHigh()).Weight(0.8).SaltDist("tens")
+	ones := NewIntSource("test-seed").Dist(WeightedLow()).Weight(0.5).SaltDist("ones")
+	die := D66()
+
+	odds := die.Odds(tens, ones)
+	const n = 200_000
+	counts := make(map[int]int)
+	for i := 0; i < n; i++ {
+		r := die.Roll(tens, ones)
+		if r.Value != r.Digits[0]*10+r.Digits[1] {
+			t.Fatalf("value %d does not read digits %v", r.Value, r.Digits)
+		}
+		counts[r.Value]++
+	}
+	for k, p := range odds {
+		if got := float64(counts[k]) / n * 100; math.Abs(got-p) > 0.3 {
+			t.Errorf("P(%d) = %.2f%%, odds give %.2f%%", k, got, p)
+		}
+	}
+}
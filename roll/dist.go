@@ -68,3 +68,13 @@ type Distribution interface {
 	// CDF computes P(X < x) for X drawn from distribution on [lower, upper)
 	CDF(x float64, p FloatParams) float64
 }
+
+// StrictDistribution is a distribution with a platform-independent variant
+// Strict casters roll and compute odds with the variant, whose results are bit-identical on
+// every Go platform; all built-in distributions implement it
+type StrictDistribution interface {
+	Distribution
+
+	// Strict returns the platform-independent variant of the distribution
+	Strict() Distribution
+}
@@ -25,14 +25,21 @@ package roll
 import (
 	"github.com/andrei-cosmin/dixe/dist"
 	"github.com/andrei-cosmin/dixe/mathx"
+	"github.com/andrei-cosmin/dixe/strict"
 )
 
 // BetaParams computes alpha and beta from weight
+// For strict casters the function must be platform-independent itself: wrap products that
+// feed additions in float64(...) so they are never fused into multiply-adds
 type BetaParams func(weight float64) (alpha, beta float64)
 
 // BetaDist is a configurable beta-based distribution
 type BetaDist struct {
 	params BetaParams
+
+	// strictParams replaces params in the strict variant when set, so built-ins can round their
+	// arithmetic explicitly there while the default formulas stay unchanged
+	strictParams BetaParams
 }
 
 // NewBetaDist creates a new beta distribution with the given params function
@@ -56,6 +63,27 @@ func (b BetaDist) CDF(x float64, p FloatParams) float64 {
 	return b.Beta(p).CDF(mathx.Normalize(x, p.Lower, p.Upper))
 }
 
+// Strict returns the platform-independent variant
+func (b BetaDist) Strict() Distribution {
+	return inverseCDF{cdf: b.strictCDF}
+}
+
+// strictCDF is the beta CDF using only platform-independent operations
+func (b BetaDist) strictCDF(x float64, p FloatParams) float64 {
+	if x <= p.Lower {
+		return 0
+	}
+	if x >= p.Upper {
+		return 1
+	}
+	params := b.params
+	if b.strictParams != nil {
+		params = b.strictParams
+	}
+	alpha, beta := params(p.Weight)
+	return strict.RegIncBeta(alpha, beta, mathx.Normalize(x, p.Lower, p.Upper))
+}
+
 // Beta returns the underlying beta distribution
 func (b BetaDist) Beta(p FloatParams) dist.Beta {
 	alpha, beta := b.params(p.Weight)
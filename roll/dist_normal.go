@@ -25,6 +25,7 @@ package roll
 import (
 	"github.com/andrei-cosmin/dixe/dist"
 	"github.com/andrei-cosmin/dixe/mathx"
+	"github.com/andrei-cosmin/dixe/strict"
 )
 
 // normalDist is a truncated normal distribution centered in the range
@@ -49,6 +50,31 @@ func (n normal) CDF(x float64, p FloatParams) float64 {
 	return n.dist(p).CDF(mathx.Normalize(x, p.Lower, p.Upper))
 }
 
+// Strict returns the platform-independent variant
+func (n normal) Strict() Distribution {
+	return inverseCDF{cdf: n.strictCDF}
+}
+
+// strictCDF is the truncated normal CDF using only platform-independent operations
+func (n normal) strictCDF(x float64, p FloatParams) float64 {
+	if x <= p.Lower {
+		return 0
+	}
+	if x >= p.Upper {
+		return 1
+	}
+	sigma := n.strictSigma(p.Weight)
+	cdfLower := strict.NormCDF(-0.5 / sigma)
+	cdfUpper := strict.NormCDF(0.5 / sigma)
+	cdfX := strict.NormCDF((mathx.Normalize(x, p.Lower, p.Upper) - 0.5) / sigma)
+	return (cdfX - cdfLower) / (cdfUpper - cdfLower)
+}
+
+// strictSigma returns the standard deviation in normalized space for a weight, never fused
+func (n normal) strictSigma(weight float64) float64 {
+	return 0.25 * (1 - float64(weight*0.8))
+}
+
 // dist returns a new truncated normal distribution based on weight
 func (n normal) dist(p FloatParams) dist.TruncatedNormal {
 	stdDev := 0.25 * (1 - p.Weight*0.8)
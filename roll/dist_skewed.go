@@ -25,7 +25,13 @@ package roll
 // skewedDist is a beta distribution for extreme values (Chaos modifier)
 // Low alpha and beta = U-shaped, values near extremes
 // Higher weight = more extreme distribution
-var skewedDist = NewBetaDist(func(w float64) (float64, float64) {
-	alpha := 0.5*(1-w) + 0.1
-	return alpha, alpha
-})
+var skewedDist = BetaDist{
+	params: func(w float64) (float64, float64) {
+		alpha := 0.5*(1-w) + 0.1
+		return alpha, alpha
+	},
+	strictParams: func(w float64) (float64, float64) {
+		alpha := float64(0.5*(1-w)) + 0.1
+		return alpha, alpha
+	},
+}
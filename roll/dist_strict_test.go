@@ -0,0 +1,100 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/internal/asmcheck"
+)
+
+// strictVectors pin strict rolls and CDFs; every platform must reproduce them bit for bit
+// Run on each target, e.g. GOARCH=386 go test ./roll or GOAMD64=v3 go test ./roll
+var strictVectors = map[string]struct {
+	rolls []uint64
+	cdf   uint64
+}{
+	"Uniform":      {[]uint64{0x3fc6fba33660c9bd, 0x3fe7686b25f6fd40, 0x3fcf7f54aabfeede, 0x3feba9eb85f603c2}, 0x3fd4cccccccccccd},
+	"Normal":       {[]uint64{0x3fd4f96bd4840b7e, 0x3fe3b79d59c77b6c, 0x3fd7bafb8db64dba, 0x3fe69b479bb6f822}, 0x3fc67f2a19f17eb1},
+	"Skewed":       {[]uint64{0x3fb04e737fc193d2, 0x3feb30cdef67e438, 0x3fc004602648da39, 0x3feee596c281d8a8}, 0x3fd93809bb54a52d},
+	"WeightedLow":  {[]uint64{0x3fb6061751b6d6f3, 0x3fdccb5d5267e55b, 0x3fbed8782f75a692, 0x3fe3199c53772bad}, 0x3fe285b061ba53d9},
+	"WeightedMin":  {[]uint64{0x0000000000000000, 0x3fd85cce49416a95, 0x0000000000000000, 0x3fe1ebae9e43493d}, 0x3fe5cb4395810628},
+	"WeightedHigh": {[]uint64{0x3fdd522a4140e129, 0x3febc2b42bdb9ed5, 0x3fe0eb20f802b809, 0x3fedf3583165fae1}, 0x3fb598b42d80d8cb},
+	"WeightedMax":  {[]uint64{0x3fe034f4f00cb89e, 0x3ff0000000000000, 0x3fe2f90e92341b4c, 0x3ff0000000000000}, 0x3fb2ed916872b026},
+}
+
+var strictCorrelated = []uint64{0x3f3db7f2d6270320, 0x3f34a55c8bc05e3a, 0x3f8db18b515746d2, 0x3f9feef9c3b884e9}
+
+func TestStrictConformance(t *testing.T) {
+	unit := FloatRange{Lower: 0, Upper: 1}
+
+	for _, d := range goldenDists {
+		t.Run(d.name, func(t *testing.T) {
+			want := strictVectors[d.name]
+			c := NewFloatSource(goldenSeed).Version(V2).Strict(true).Dist(d.dist).Weight(0.3).SaltDist(goldenSalt)
+			for i, bits := range want.rolls {
+				if got := math.Float64bits(c.One(unit).First); got != bits {
+					t.Errorf("roll %d: got %#016x, want %#016x", i, got, bits)
+				}
+			}
+
+			p := FloatParams{Range: FloatRange{Lower: 1, Upper: 21}, Weight: 0.3}
+			if got := math.Float64bits(c.cfg.distribution().CDF(7.5, p)); got != want.cdf {
+				t.Errorf("CDF: got %#016x, want %#016x", got, want.cdf)
+			}
+		})
+	}
+
+	c := NewFloatSource(goldenSeed).Version(V2).Strict(true).Dist(Skewed()).Correlation(0.8).SaltDist(goldenSalt)
+	for i, bits := range strictCorrelated {
+		if got := math.Float64bits(c.One(unit).First); got != bits {
+			t.Errorf("correlated roll %d: got %#016x, want %#016x", i, got, bits)
+		}
+	}
+}
+
+func TestStrictOddsMatch(t *testing.T) {
+	for _, d := range goldenDists {
+		for _, w := range []float64{0, 0.5, 1} {
+			loose := NewIntSource(goldenSeed).Dist(d.dist).Weight(w).SaltDist(goldenSalt).Odds(D20())
+			exact := NewIntSource(goldenSeed).Dist(d.dist).Weight(w).Strict(true).SaltDist(goldenSalt).Odds(D20())
+			for v, want := range loose.Probabilities {
+				if math.Abs(exact.Probabilities[v]-want) > 1e-9 {
+					t.Errorf("%s/%.1f bucket %d: strict %.12f%%, want %.12f%%", d.name, w, v, exact.Probabilities[v], want)
+				}
+			}
+		}
+	}
+}
+
+// strictEntries matches the entry points of strict sampling: every Strict method, and the latent
+// chain of correlated casters
+var strictEntries = regexp.MustCompile(`^github\.com/andrei-cosmin/dixe/roll\..*\.(Strict|advanceLatent|normFloat64|latentCDF)$`)
+
+// TestStrictNotFused fails if a function reachable from a strict entry point emits a fused
+// multiply-add on an architecture that fuses; default paths may fuse freely
+func TestStrictNotFused(t *testing.T) {
+	asmcheck.Check(t, strictEntries.MatchString)
+}
@@ -0,0 +1,203 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/andrei-cosmin/dixe/mathx"
+)
+
+// cumulativeTolerance is how far a cumulative table may end from 1
+const cumulativeTolerance = 1e-9
+
+// tabulated is a distribution defined by a table over the normalized [0, 1) domain
+// Knots are evenly spaced, cum holds the normalized CDF at each knot, and density the
+// normalized density at each knot for piecewise-linear densities (nil otherwise)
+type tabulated struct {
+	cum     []float64
+	density []float64
+}
+
+// NewStepDensity creates a distribution from density values on equal-width bins of [0, 1)
+// The density is constant within each bin, and normalized so it need not sum to 1
+// Returns an error if a value is negative or not finite, or if all values are zero
+func NewStepDensity(density []float64) (Distribution, error) {
+	if err := validateDensity(density, 1); err != nil {
+		return nil, err
+	}
+	cum := make([]float64, len(density)+1)
+	for i, d := range density {
+		cum[i+1] = cum[i] + d
+	}
+	normalize(cum, cum[len(cum)-1])
+	return tabulated{cum: cum}, nil
+}
+
+// NewLinearDensity creates a distribution from density values at evenly spaced points of [0, 1],
+// the first at 0 and the last at 1, interpolated linearly in between
+// The density is normalized so it need not integrate to 1
+// Returns an error if there are fewer than 2 values, a value is negative or not finite,
+// or if all values are zero
+func NewLinearDensity(density []float64) (Distribution, error) {
+	if err := validateDensity(density, 2); err != nil {
+		return nil, err
+	}
+	segments := float64(len(density) - 1)
+	cum := make([]float64, len(density))
+	for i := 1; i < len(density); i++ {
+		cum[i] = cum[i-1] + float64((density[i-1]+density[i])/2)/segments
+	}
+	total := cum[len(cum)-1]
+	normalize(cum, total)
+	normalized := make([]float64, len(density))
+	for i, d := range density {
+		normalized[i] = d / total
+	}
+	return tabulated{cum: cum, density: normalized}, nil
+}
+
+// NewCumulative creates a distribution from CDF values at evenly spaced points of [0, 1],
+// the first at 0 and the last at 1, interpolated linearly in between
+// Returns an error if there are fewer than 2 values, the values decrease or are not finite,
+// or if they do not start at 0 and end at 1
+func NewCumulative(cdf []float64) (Distribution, error) {
+	if len(cdf) < 2 {
+		return nil, fmt.Errorf("table: need at least 2 cumulative values, got %d", len(cdf))
+	}
+	for i, v := range cdf {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("table: cumulative value %v at %d is not finite", v, i)
+		}
+		if i > 0 && v < cdf[i-1] {
+			return nil, fmt.Errorf("table: cumulative value %v at %d decreases from %v", v, i, cdf[i-1])
+		}
+	}
+	if cdf[0] != 0 || math.Abs(cdf[len(cdf)-1]-1) > cumulativeTolerance {
+		return nil, fmt.Errorf("table: cumulative values must go from 0 to 1, got %v to %v", cdf[0], cdf[len(cdf)-1])
+	}
+	cum := make([]float64, len(cdf))
+	copy(cum, cdf)
+	cum[len(cum)-1] = 1
+	return tabulated{cum: cum}, nil
+}
+
+// validateDensity checks density values are finite, non-negative and not all zero
+func validateDensity(density []float64, minLen int) error {
+	if len(density) < minLen {
+		return fmt.Errorf("table: need at least %d density values, got %d", minLen, len(density))
+	}
+	var total float64
+	for i, d := range density {
+		if math.IsNaN(d) || math.IsInf(d, 0) || d < 0 {
+			return fmt.Errorf("table: density %v at %d is negative or not finite", d, i)
+		}
+		total += d
+	}
+	if total == 0 {
+		return fmt.Errorf("table: density is zero everywhere")
+	}
+	return nil
+}
+
+// normalize divides the cumulative values by the total, pinning the last one to exactly 1
+func normalize(cum []float64, total float64) {
+	for i := range cum {
+		cum[i] /= total
+	}
+	cum[len(cum)-1] = 1
+}
+
+// Rand generates a random value by inverting the CDF
+// The segment is found by binary search over the cumulative table, then inverted exactly
+func (t tabulated) Rand(p FloatParams) float64 {
+	u := float64(p.Rng.Float64())
+	segments := len(t.cum) - 1
+	i := sort.Search(len(t.cum), func(j int) bool { return t.cum[j] > u }) - 1
+	i = max(0, min(segments-1, i))
+
+	mass := t.cum[i+1] - t.cum[i]
+	var frac float64
+	switch {
+	case mass <= 0:
+		frac = 0
+	case t.density == nil:
+		frac = (u - t.cum[i]) / mass
+	default:
+		frac = t.invertLinear(i, u-t.cum[i])
+	}
+	normalized := (float64(i) + max(0, min(1, frac))) / float64(segments)
+	return p.Lower + float64(normalized*(p.Upper-p.Lower))
+}
+
+// invertLinear solves h*(d0*f + (d1-d0)*f^2/2) = r for the fraction f of segment i
+func (t tabulated) invertLinear(i int, r float64) float64 {
+	h := 1 / float64(len(t.cum)-1)
+	b := float64(t.density[i] * h)
+	a := float64((t.density[i+1] - t.density[i]) * h / 2)
+	// Stable root of a*f^2 + b*f - r = 0 that lies in [0, 1]
+	disc := float64(b*b) + float64(4*a*r)
+	return float64(2*r) / (b + math.Sqrt(max(0, disc)))
+}
+
+// CDF returns the cumulative distribution function at x
+func (t tabulated) CDF(x float64, p FloatParams) float64 {
+	if x <= p.Lower {
+		return 0
+	}
+	if x >= p.Upper {
+		return 1
+	}
+	segments := len(t.cum) - 1
+	pos := float64(mathx.Normalize(x, p.Lower, p.Upper) * float64(segments))
+	i := min(segments-1, int(pos))
+	frac := pos - float64(i)
+
+	if t.density == nil {
+		return t.cum[i] + float64(frac*(t.cum[i+1]-t.cum[i]))
+	}
+	h := 1 / float64(segments)
+	d0, d1 := t.density[i], t.density[i+1]
+	return t.cum[i] + float64(h*(float64(d0*frac)+float64((d1-d0)*frac*frac/2)))
+}
+
+// Strict returns the distribution itself, whose table lookups are already platform-independent
+func (t tabulated) Strict() Distribution {
+	return t
+}
+
+// distName identifies the table by its size and a digest of its knots
+func (t tabulated) distName(float64) string {
+	fp := &fingerprinter{}
+	fp.uint(uint64(len(t.cum)))
+	for _, c := range t.cum {
+		fp.float(c)
+	}
+	fp.uint(uint64(len(t.density)))
+	for _, d := range t.density {
+		fp.float(d)
+	}
+	return fmt.Sprintf("table(%d,%016x)", len(t.cum), fp.sum(0).Hash)
+}
@@ -0,0 +1,68 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTableOdds(t *testing.T) {
+	d, err := NewStepDensity([]float64{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	odds := NewIntSource("test-seed").Dist(d).SaltDist("odds").Odds(D4()).Probabilities
+	for v, want := range map[int]float64{1: 12.5, 2: 12.5, 3: 37.5, 4: 37.5} {
+		if math.Abs(odds[v]-want) > 1e-9 {
+			t.Errorf("bucket %d: got %v%%, want %v%%", v, odds[v], want)
+		}
+	}
+
+	// A triangular density from 0 up to 1 has CDF x^2
+	tri, err := NewLinearDensity([]float64{0, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := FloatParams{Range: FloatRange{Lower: 0, Upper: 1}}
+	if got := tri.CDF(0.5, p); math.Abs(got-0.25) > 1e-12 {
+		t.Errorf("triangular CDF(0.5): got %v, want 0.25", got)
+	}
+}
+
+func TestTableValidation(t *testing.T) {
+	invalid := map[string]func() (Distribution, error){
+		"negative density":   func() (Distribution, error) { return NewStepDensity([]float64{1, -1}) },
+		"zero density":       func() (Distribution, error) { return NewStepDensity([]float64{0, 0}) },
+		"NaN density":        func() (Distribution, error) { return NewLinearDensity([]float64{1, math.NaN()}) },
+		"short linear":       func() (Distribution, error) { return NewLinearDensity([]float64{1}) },
+		"decreasing CDF":     func() (Distribution, error) { return NewCumulative([]float64{0, 0.6, 0.5, 1}) },
+		"unnormalized CDF":   func() (Distribution, error) { return NewCumulative([]float64{0, 0.5, 0.9}) },
+		"CDF not starting 0": func() (Distribution, error) { return NewCumulative([]float64{0.1, 1}) },
+	}
+	for name, load := range invalid {
+		if _, err := load(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
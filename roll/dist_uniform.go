@@ -35,6 +35,11 @@ func (u uniform) Rand(p FloatParams) float64 {
 	return p.Lower + p.Rng.Float64()*(p.Upper-p.Lower)
 }
 
+// Strict returns the platform-independent variant
+func (u uniform) Strict() Distribution {
+	return strictUniform{}
+}
+
 // CDF returns the cumulative distribution function at x
 func (u uniform) CDF(x float64, p FloatParams) float64 {
 	if x <= p.Lower {
@@ -45,3 +50,13 @@ func (u uniform) CDF(x float64, p FloatParams) float64 {
 	}
 	return mathx.Normalize(x, p.Lower, p.Upper)
 }
+
+// strictUniform is a uniform distribution whose scaling is never fused into a multiply-add
+type strictUniform struct {
+	uniform
+}
+
+// Rand generates a random value in [lower, upper]
+func (u strictUniform) Rand(p FloatParams) float64 {
+	return p.Lower + float64(p.Rng.Float64()*(p.Upper-p.Lower))
+}
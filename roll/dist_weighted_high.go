@@ -25,9 +25,14 @@ package roll
 import "github.com/andrei-cosmin/dixe/mathx"
 
 // weightedHighDist biases toward the upper half using beta distribution with alpha > beta
-var weightedHighDist = NewBetaDist(func(w float64) (float64, float64) {
-	return 1.0 + w*4, 1.0
-})
+var weightedHighDist = BetaDist{
+	params: func(w float64) (float64, float64) {
+		return 1.0 + w*4, 1.0
+	},
+	strictParams: func(w float64) (float64, float64) {
+		return 1.0 + float64(w*4), 1.0
+	},
+}
 
 // weightedMaxDist has strong bias toward maximum with direct probability check
 var weightedMaxDist = weightedMax{
@@ -40,6 +45,19 @@ type weightedMax struct {
 	BetaDist
 }
 
+// Strict returns the platform-independent variant
+func (w weightedMax) Strict() Distribution {
+	return inverseCDF{cdf: func(x float64, p FloatParams) float64 {
+		if x <= p.Lower {
+			return 0
+		}
+		if x >= p.Upper {
+			return 1
+		}
+		return float64((1 - p.Weight) * w.BetaDist.strictCDF(x, p))
+	}}
+}
+
 func (w weightedMax) Rand(p FloatParams) float64 {
 	if p.Rng.Float64() < p.Weight {
 		return p.Upper
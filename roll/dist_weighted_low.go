@@ -25,9 +25,14 @@ package roll
 import "github.com/andrei-cosmin/dixe/mathx"
 
 // weightedLowDist biases toward the lower half using beta distribution with alpha < beta
-var weightedLowDist = NewBetaDist(func(w float64) (float64, float64) {
-	return 1.0, 1.0 + w*4
-})
+var weightedLowDist = BetaDist{
+	params: func(w float64) (float64, float64) {
+		return 1.0, 1.0 + w*4
+	},
+	strictParams: func(w float64) (float64, float64) {
+		return 1.0, 1.0 + float64(w*4)
+	},
+}
 
 // weightedMinDist has strong bias toward minimum with direct probability check
 var weightedMinDist = weightedMin{
@@ -40,6 +45,19 @@ type weightedMin struct {
 	BetaDist
 }
 
+// Strict returns the platform-independent variant
+func (w weightedMin) Strict() Distribution {
+	return inverseCDF{cdf: func(x float64, p FloatParams) float64 {
+		if x <= p.Lower {
+			return 0
+		}
+		if x >= p.Upper {
+			return 1
+		}
+		return p.Weight + float64((1-p.Weight)*w.BetaDist.strictCDF(x, p))
+	}}
+}
+
 func (w weightedMin) Rand(p FloatParams) float64 {
 	if p.Rng.Float64() < p.Weight {
 		return p.Lower
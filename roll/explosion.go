@@ -0,0 +1,240 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ExplosionMode selects how the extra rolls of an explosion count toward the result
+type ExplosionMode int
+
+const (
+	// ExplosionAdd adds each extra roll as another die
+	ExplosionAdd ExplosionMode = iota
+
+	// ExplosionCompound adds each extra roll into the die that exploded (see Result.Dice)
+	ExplosionCompound
+
+	// ExplosionPenetrating adds each extra roll minus 1
+	ExplosionPenetrating
+
+	// ExplosionImplode subtracts each extra roll
+	ExplosionImplode
+)
+
+// String returns the mode name
+func (m ExplosionMode) String() string {
+	switch m {
+	case ExplosionAdd:
+		return "add"
+	case ExplosionCompound:
+		return "compound"
+	case ExplosionPenetrating:
+		return "penetrating"
+	case ExplosionImplode:
+		return "implode"
+	default:
+		return fmt.Sprintf("mode(%d)", int(m))
+	}
+}
+
+// triggerKind selects the condition that makes a roll explode
+type triggerKind int
+
+const (
+	triggerAbove triggerKind = iota
+	triggerBelow
+	triggerMax
+	triggerMin
+	triggerWhen
+)
+
+// Explosion is a policy rolling extra values while a roll triggers it
+// The chain starts from the first roll, and each extra roll can trigger the next one,
+// up to the policy maximum. Policies run in order, after the legacy RerollBelow/RerollAbove chains
+type Explosion[T constraint] struct {
+	name      string
+	mode      ExplosionMode
+	kind      triggerKind
+	threshold T
+	when      func(T) bool
+	rolls     *Range[T]
+	max       int
+}
+
+// ExplodeAbove explodes rolls strictly above v, up to max extra rolls
+func ExplodeAbove[T constraint](v T, max int) Explosion[T] {
+	return Explosion[T]{name: fmt.Sprintf("above %v", v), kind: triggerAbove, threshold: v, max: max}
+}
+
+// ExplodeBelow explodes rolls strictly below v, up to max extra rolls
+func ExplodeBelow[T constraint](v T, max int) Explosion[T] {
+	return Explosion[T]{name: fmt.Sprintf("below %v", v), kind: triggerBelow, threshold: v, max: max}
+}
+
+// ExplodeOnMax explodes rolls of the highest face of their range, up to max extra rolls
+func ExplodeOnMax[T constraint](max int) Explosion[T] {
+	return Explosion[T]{name: "max", kind: triggerMax, max: max}
+}
+
+// ExplodeOnMin explodes rolls of the lowest face of their range, up to max extra rolls
+func ExplodeOnMin[T constraint](max int) Explosion[T] {
+	return Explosion[T]{name: "min", kind: triggerMin, max: max}
+}
+
+// ExplodeWhen explodes rolls for which the predicate holds, up to max extra rolls
+// The default name is "when"; casters number repeated names (see Named)
+func ExplodeWhen[T constraint](when func(T) bool, max int) Explosion[T] {
+	return Explosion[T]{name: "when", kind: triggerWhen, when: when, max: max}
+}
+
+// Named sets the name recorded in Result.Extras and Odds.ExplosionChances
+// When several policies of a caster share a name, the repeats are numbered in order ("when", "when 2", ...)
+// so each keeps its own chance
+func (e Explosion[T]) Named(name string) Explosion[T] {
+	e.name = name
+	return e
+}
+
+// Compound adds the extra rolls into the die that exploded
+func (e Explosion[T]) Compound() Explosion[T] {
+	e.mode = ExplosionCompound
+	return e
+}
+
+// Penetrating adds the extra rolls minus 1
+func (e Explosion[T]) Penetrating() Explosion[T] {
+	e.mode = ExplosionPenetrating
+	return e
+}
+
+// Implode subtracts the extra rolls
+func (e Explosion[T]) Implode() Explosion[T] {
+	e.mode = ExplosionImplode
+	return e
+}
+
+// Rolling rolls the extra values on r instead of the range of the roll
+// WeightedCaster restricts its weights to r
+func (e Explosion[T]) Rolling(r Range[T]) Explosion[T] {
+	e.rolls = &r
+	return e
+}
+
+// Name returns the policy name
+func (e Explosion[T]) Name() string {
+	return e.name
+}
+
+// Mode returns the policy mode
+func (e Explosion[T]) Mode() ExplosionMode {
+	return e.mode
+}
+
+// triggers reports whether a roll on the range explodes
+func (e Explosion[T]) triggers(v T, r Range[T]) bool {
+	switch e.kind {
+	case triggerAbove:
+		return v > e.threshold
+	case triggerBelow:
+		return v < e.threshold
+	case triggerMax:
+		return v >= r.Upper
+	case triggerMin:
+		return v <= r.Lower
+	default:
+		return e.when != nil && e.when(v)
+	}
+}
+
+// contribution returns what an extra roll adds to the sum
+func (e Explosion[T]) contribution(v T) T {
+	switch e.mode {
+	case ExplosionPenetrating:
+		return v - 1
+	case ExplosionImplode:
+		return -v
+	default:
+		return v
+	}
+}
+
+// explode runs the policies from the first roll, drawing each extra roll on its range
+// dice is the number of dice already rolled, the first one included
+func explode[T constraint](policies []Explosion[T], first T, r Range[T], dice int, draw func(Range[T]) T) []ExtraRoll[T] {
+	var extras []ExtraRoll[T]
+	for _, e := range policies {
+		current, currentRange := first, r
+		rolls := r
+		if e.rolls != nil {
+			rolls = *e.rolls
+		}
+		for n := 0; n < e.max && e.triggers(current, currentRange); n++ {
+			current, currentRange = draw(rolls), rolls
+			die := 0
+			if e.mode != ExplosionCompound {
+				die, dice = dice, dice+1
+			}
+			extras = append(extras, ExtraRoll[T]{
+				Policy:       e.name,
+				Mode:         e.mode,
+				Value:        current,
+				Contribution: e.contribution(current),
+				Die:          die,
+			})
+		}
+	}
+	return extras
+}
+
+// legacyExtras records the legacy threshold chains as extra rolls
+func legacyExtras[T constraint](lower, upper []T) []ExtraRoll[T] {
+	if len(lower)+len(upper) == 0 {
+		return nil
+	}
+	extras := make([]ExtraRoll[T], 0, len(lower)+len(upper))
+	for _, v := range lower {
+		extras = append(extras, ExtraRoll[T]{Policy: "lower", Value: v, Contribution: v, Die: len(extras) + 1})
+	}
+	for _, v := range upper {
+		extras = append(extras, ExtraRoll[T]{Policy: "upper", Value: v, Contribution: v, Die: len(extras) + 1})
+	}
+	return extras
+}
+
+// uniqueNames returns a copy of the policies with repeated names numbered in order
+func uniqueNames[T constraint](policies []Explosion[T]) []Explosion[T] {
+	policies = slices.Clone(policies)
+	seen := make(map[string]bool, len(policies))
+	for i, e := range policies {
+		name := e.name
+		for n := 2; seen[name]; n++ {
+			name = fmt.Sprintf("%s %d", e.name, n)
+		}
+		policies[i].name = name
+		seen[name] = true
+	}
+	return policies
+}
@@ -0,0 +1,185 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExplosionPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Explosion[int]
+		check  func(r IntResult) bool
+	}{
+		{"add", ExplodeOnMax[int](3), func(r IntResult) bool {
+			return len(r.Dice()) == len(r.Rolls)
+		}},
+		{"compound", ExplodeOnMax[int](3).Compound(), func(r IntResult) bool {
+			return len(r.Dice()) == 1 && r.Dice()[0] == r.Sum
+		}},
+		{"penetrating", ExplodeOnMax[int](3).Penetrating(), func(r IntResult) bool {
+			for _, e := range r.Extras {
+				if e.Contribution != e.Value-1 {
+					return false
+				}
+			}
+			return true
+		}},
+		{"implode", ExplodeOnMin[int](1).Implode(), func(r IntResult) bool {
+			return len(r.Extras) == 0 || r.Sum == 1-r.Extras[0].Value
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caster := NewIntSource("test-seed").SaltDist(tt.name).Explode(tt.policy)
+			var exploded int
+			for _, r := range caster.Multiple(2000, D6()) {
+				triggered := tt.policy.triggers(r.First, D6())
+				if triggered != (len(r.Extras) > 0) {
+					t.Fatalf("first roll %d: triggered %t, extras %v", r.First, triggered, r.Extras)
+				}
+				sum := r.First
+				for i, e := range r.Extras {
+					if e.Policy != tt.policy.Name() || e.Mode != tt.policy.Mode() || r.Rolls[i+1] != e.Value {
+						t.Fatalf("extra roll not recorded: %+v", r)
+					}
+					sum += e.Contribution
+				}
+				if sum != r.Sum || !tt.check(r) {
+					t.Fatalf("inconsistent result: %+v", r)
+				}
+				if triggered {
+					exploded++
+				}
+			}
+			if exploded == 0 {
+				t.Error("expected some explosions")
+			}
+		})
+	}
+}
+
+func TestExplosionRange(t *testing.T) {
+	// A natural 20 adds a d6, and a 6 on it adds another d6
+	caster := NewIntSource("test-seed").SaltDist("range").Explode(ExplodeOnMax[int](5).Rolling(D6()))
+	var extras int
+	for _, r := range caster.Multiple(2000, D20()) {
+		for i, e := range r.Extras {
+			extras++
+			if e.Value < 1 || e.Value > 6 {
+				t.Fatalf("extra roll %d outside the d6", e.Value)
+			}
+			if i > 0 && r.Extras[i-1].Value != 6 {
+				t.Fatalf("extra rolls should chain on the max face of their own range: %v", r.Extras)
+			}
+		}
+	}
+	if extras == 0 {
+		t.Error("expected some explosions")
+	}
+}
+
+func TestDiceMixedModes(t *testing.T) {
+	caster := NewIntSource("test-seed").SaltDist("mixed").Explode(ExplodeOnMax[int](2), ExplodeOnMax[int](3).Compound())
+	var checked int
+	for _, r := range caster.Multiple(2000, D6()) {
+		added, compounded := 0, r.First
+		for _, e := range r.Extras {
+			if e.Mode == ExplosionCompound {
+				compounded += e.Contribution
+			} else {
+				added++
+			}
+		}
+		dice := r.Dice()
+		if len(dice) != 1+added || dice[0] != compounded {
+			t.Fatalf("compounded rolls should extend the first die, got %v for %+v", dice, r.Extras)
+		}
+		if added > 0 && len(r.Extras) > added {
+			checked++
+		}
+	}
+	if checked == 0 {
+		t.Error("expected rolls with both added and compounded extras")
+	}
+}
+
+func TestExplosionOdds(t *testing.T) {
+	even := ExplodeWhen(func(v int) bool { return v%2 == 0 }, 1).Named("even")
+	odds := NewIntSource("test-seed").SaltDist("odds").Explode(ExplodeOnMax[int](1), ExplodeAbove(4, 1), even).Odds(D6())
+	for name, want := range map[string]float64{"max": 100.0 / 6, "above 4": 100.0 / 3, "even": 50} {
+		if got := odds.ExplosionChances[name]; math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: got %v%%, want %v%%", name, got, want)
+		}
+	}
+
+	weighted := NewIntSource("test-seed").SaltCustomWeighted("odds", IntWeights{1: 1, 2: 1, 3: 2}).Explode(ExplodeOnMax[int](2))
+	if got := weighted.Odds().ExplosionChances["max"]; got != 50 {
+		t.Errorf("weighted max: got %v%%, want 50%%", got)
+	}
+	for _, r := range weighted.Multiple(200) {
+		if (r.First == 3) != (len(r.Extras) > 0) {
+			t.Fatalf("weighted caster should explode on its highest value: %+v", r)
+		}
+	}
+}
+
+func TestExplosionNamesUnique(t *testing.T) {
+	even := ExplodeWhen(func(v int) bool { return v%2 == 0 }, 1)
+	six := ExplodeWhen(func(v int) bool { return v == 6 }, 1)
+	odds := NewIntSource("test-seed").SaltDist("names").Explode(even, six).Odds(D6())
+	for name, want := range map[string]float64{"when": 50, "when 2": 100.0 / 6} {
+		if got := odds.ExplosionChances[name]; math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: got %v%%, want %v%%", name, got, want)
+		}
+	}
+}
+
+func TestWeightedSpanSkipsZeroWeights(t *testing.T) {
+	weighted := NewIntSource("test-seed").SaltCustomWeighted("span", IntWeights{1: 1, 2: 1, 3: 2, 4: 0}).Explode(ExplodeOnMax[int](1))
+	if got := weighted.Odds().ExplosionChances["max"]; got != 50 {
+		t.Errorf("max: got %v%%, want 50%%", got)
+	}
+	for _, r := range weighted.Multiple(200) {
+		if (r.First == 3) != (len(r.Extras) > 0) {
+			t.Fatalf("weighted caster should explode on its highest rollable value: %+v", r)
+		}
+	}
+}
+
+func TestLegacyExplosionsRecorded(t *testing.T) {
+	caster := NewIntSource("test-seed").SaltDist("legacy").RerollAbove(18).UpperExplosions(2)
+	for _, r := range caster.Multiple(500, D20()) {
+		if len(r.Extras) != r.UpperExplosions {
+			t.Fatalf("legacy explosions should be recorded: %+v", r)
+		}
+		for _, e := range r.Extras {
+			if e.Policy != "upper" || e.Mode != ExplosionAdd {
+				t.Fatalf("legacy explosion recorded as %+v", e)
+			}
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Symbols is a multiset of die symbols, mapping each symbol to its count
+// Counts may be negative for signed symbols such as Fate shifts
+type Symbols map[string]int
+
+// Face is a die face holding a payload and symbols
+type Face[P any] struct {
+	// Payload is an arbitrary value carried by the face
+	Payload P
+
+	// Symbols are the symbols shown on the face
+	Symbols Symbols
+}
+
+// FacedDie is a die with arbitrary faces, rolled as a face index through a caster
+// The caster rolls on 1..len(faces), so distributions shape the odds of each face, and a
+// WeightedCaster weights faces by index
+type FacedDie[P any] struct {
+	faces []Face[P]
+}
+
+// Cancellation is a rule where each A symbol cancels one B symbol (e.g. success and failure)
+type Cancellation struct {
+	A, B string
+}
+
+// FacedPool is a pool of faced dice whose symbols are totalled with cancellation rules
+type FacedPool[P any] struct {
+	dice    []*FacedDie[P]
+	cancels []Cancellation
+}
+
+// FacedResult is the outcome of a faced pool roll
+type FacedResult[P any] struct {
+	// Faces holds the face rolled on each die, in pool order
+	Faces []Face[P]
+
+	// Raw is the total of each symbol before cancellation
+	Raw Symbols
+
+	// Symbols is the total of each symbol after cancellation, without zero counts
+	Symbols Symbols
+}
+
+// SymbolOutcome is a distinct symbol total and its probability
+type SymbolOutcome struct {
+	// Symbols is the total after cancellation, without zero counts
+	Symbols Symbols
+
+	// Chance is the probability of the total (0-100%)
+	Chance float64
+}
+
+// SymbolOdds contains the exact probabilities of the symbol totals of a faced pool
+type SymbolOdds struct {
+	// Outcomes holds every possible total, most likely first
+	Outcomes []SymbolOutcome
+}
+
+// NewFacedDie creates a die with the given faces
+func NewFacedDie[P any](faces ...Face[P]) *FacedDie[P] {
+	if len(faces) == 0 {
+		panic("faces: a die needs at least one face")
+	}
+	return &FacedDie[P]{faces: slices.Clone(faces)}
+}
+
+// NewSymbolDie creates a die whose faces only hold symbols (an empty Symbols is a blank face)
+func NewSymbolDie(faces ...Symbols) *FacedDie[struct{}] {
+	die := make([]Face[struct{}], len(faces))
+	for i, s := range faces {
+		die[i] = Face[struct{}]{Symbols: s}
+	}
+	return NewFacedDie(die...)
+}
+
+// Fudge creates a Fate die: two faces each of -1, 0 and +1, as payload and as the "shift" symbol
+func Fudge() *FacedDie[int] {
+	faces := make([]Face[int], 0, 6)
+	for _, v := range []int{-1, -1, 0, 0, 1, 1} {
+		faces = append(faces, Face[int]{Payload: v, Symbols: Symbols{"shift": v}})
+	}
+	return NewFacedDie(faces...)
+}
+
+// Faces returns a copy of the die faces
+func (d *FacedDie[P]) Faces() []Face[P] {
+	return slices.Clone(d.faces)
+}
+
+// Range returns the face index range rolled by the caster
+func (d *FacedDie[P]) Range() IntRange {
+	return IntRange{Lower: 1, Upper: len(d.faces)}
+}
+
+// Roll rolls a face with the caster
+func (d *FacedDie[P]) Roll(c Caster[int]) Face[P] {
+	i := c.One(d.Range()).First
+	if i < 1 || i > len(d.faces) {
+		panic(fmt.Sprintf("faces: face index %d out of range 1..%d", i, len(d.faces)))
+	}
+	return d.faces[i-1]
+}
+
+// Odds returns the probability of each face index (1-based, 0-100%)
+func (d *FacedDie[P]) Odds(c Caster[int]) map[int]float64 {
+	odds := make(map[int]float64, len(d.faces))
+	for i, p := range c.Odds(d.Range()).Probabilities {
+		if i >= 1 && i <= len(d.faces) {
+			odds[i] = p
+		}
+	}
+	return odds
+}
+
+// NewFacedPool creates a pool of faced dice
+func NewFacedPool[P any](dice ...*FacedDie[P]) *FacedPool[P] {
+	return &FacedPool[P]{dice: slices.Clone(dice)}
+}
+
+// Cancel adds a rule where each a symbol cancels one b symbol
+// Rules apply in the order they were added
+func (p *FacedPool[P]) Cancel(a, b string) *FacedPool[P] {
+	p.cancels = append(p.cancels, Cancellation{A: a, B: b})
+	return p
+}
+
+// Roll rolls every die of the pool with the caster, in order
+func (p *FacedPool[P]) Roll(c Caster[int]) FacedResult[P] {
+	result := FacedResult[P]{Faces: make([]Face[P], len(p.dice)), Raw: Symbols{}}
+	for i, d := range p.dice {
+		result.Faces[i] = d.Roll(c)
+		result.Raw.add(result.Faces[i].Symbols)
+	}
+	result.Symbols = result.Raw.cancel(p.cancels)
+	return result
+}
+
+// Odds calculates the exact probabilities of the symbol totals from the caster's odds for each die
+func (p *FacedPool[P]) Odds(c Caster[int]) SymbolOdds {
+	// Faces and outcomes are visited in a fixed order, so the sums are identical on every run
+	totals := map[string]SymbolOutcome{"": {Symbols: Symbols{}, Chance: 1}}
+	for _, d := range p.dice {
+		chances := d.Odds(c)
+		next := make(map[string]SymbolOutcome)
+		for i, face := range d.faces {
+			chance := chances[i+1]
+			if chance == 0 {
+				continue
+			}
+			for _, key := range slices.Sorted(maps.Keys(totals)) {
+				o := totals[key]
+				s := maps.Clone(o.Symbols)
+				s.add(face.Symbols)
+				s = s.cancel(nil)
+				k := s.key()
+				next[k] = SymbolOutcome{Symbols: s, Chance: next[k].Chance + o.Chance*chance/100}
+			}
+		}
+		totals = next
+	}
+
+	net := make(map[string]SymbolOutcome)
+	for _, key := range slices.Sorted(maps.Keys(totals)) {
+		o := totals[key]
+		s := o.Symbols.cancel(p.cancels)
+		k := s.key()
+		net[k] = SymbolOutcome{Symbols: s, Chance: net[k].Chance + o.Chance*100}
+	}
+	odds := SymbolOdds{Outcomes: slices.Collect(maps.Values(net))}
+	slices.SortFunc(odds.Outcomes, func(a, b SymbolOutcome) int {
+		if c := cmp.Compare(b.Chance, a.Chance); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Symbols.key(), b.Symbols.key())
+	})
+	return odds
+}
+
+// Count returns the distribution of a symbol's total after cancellation (0-100%)
+func (o SymbolOdds) Count(symbol string) map[int]float64 {
+	counts := make(map[int]float64)
+	for _, out := range o.Outcomes {
+		counts[out.Symbols[symbol]] += out.Chance
+	}
+	return counts
+}
+
+// AtLeast returns the probability that a symbol totals at least n after cancellation (0-100%)
+func (o SymbolOdds) AtLeast(symbol string, n int) float64 {
+	var sum float64
+	for _, out := range o.Outcomes {
+		if out.Symbols[symbol] >= n {
+			sum += out.Chance
+		}
+	}
+	return sum
+}
+
+// add adds the counts of other
+func (s Symbols) add(other Symbols) {
+	for k, v := range other {
+		s[k] += v
+	}
+}
+
+// cancel returns a copy with the cancellation rules applied and zero counts removed
+func (s Symbols) cancel(rules []Cancellation) Symbols {
+	out := maps.Clone(s)
+	for _, r := range rules {
+		n := min(out[r.A], out[r.B])
+		if n > 0 {
+			out[r.A] -= n
+			out[r.B] -= n
+		}
+	}
+	maps.DeleteFunc(out, func(_ string, v int) bool { return v == 0 })
+	return out
+}
+
+// key returns a canonical encoding of the symbols
+func (s Symbols) key() string {
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(s)) {
+		if s[k] != 0 {
+			fmt.Fprintf(&b, "%q:%d;", k, s[k])
+		}
+	}
+	return b.String()
+}
+
+// String returns the symbols in name order, e.g. "advantage:1 success:2"
+func (s Symbols) String() string {
+	parts := make([]string, 0, len(s))
+	for _, k := range slices.Sorted(maps.Keys(s)) {
+		parts = append(parts, fmt.Sprintf("%s:%d", k, s[k]))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,104 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFudgeOdds(t *testing.T) {
+	pool := NewFacedPool(Fudge(), Fudge(), Fudge(), Fudge())
+	counts := pool.Odds(NewIntSource("test-seed").SaltDist("fate")).Count("shift")
+
+	ways := map[int]float64{-4: 1, -3: 4, -2: 10, -1: 16, 0: 19, 1: 16, 2: 10, 3: 4, 4: 1}
+	for v, n := range ways {
+		if want := n / 81 * 100; math.Abs(counts[v]-want) > 1e-9 {
+			t.Errorf("P(%+d) = %v, want %v", v, counts[v], want)
+		}
+	}
+}
+
+func TestFacedPoolMatchesOdds(t *testing.T) {
+	ability := NewSymbolDie(
+		Symbols{}, Symbols{"success": 1}, Symbols{"success": 1}, Symbols{"success": 2},
+		Symbols{"advantage": 1}, Symbols{"advantage": 1}, Symbols{"success": 1, "advantage": 1}, Symbols{"advantage": 2},
+	)
+	difficulty := NewSymbolDie(
+		Symbols{}, Symbols{"failure": 1}, Symbols{"failure": 2}, Symbols{"threat": 1},
+		Symbols{"threat": 1}, Symbols{"threat": 1}, Symbols{"threat": 2}, Symbols{"failure": 1, "threat": 1},
+	)
+	pool := NewFacedPool(ability, ability, difficulty).Cancel("success", "failure").Cancel("advantage", "threat")
+
+	casters := map[string]IntCaster{
+		"dist":     NewIntSource("test-seed").Dist(Normal()).Weight(0.4).SaltDist("genesys"),
+		"weighted": NewIntSource("test-seed").SaltCustomWeighted("genesys", IntWeights{1: 1, 2: 2, 3: 3, 4: 1, 5: 1, 6: 2, 7: 1, 8: 1}),
+	}
+
+	const n = 100_000
+	for name, c := range casters {
+		t.Run(name, func(t *testing.T) {
+			odds := pool.Odds(c)
+			for range 20 {
+				if again := pool.Odds(c); !reflect.DeepEqual(again, odds) {
+					t.Fatal("odds should be identical on every call")
+				}
+			}
+			var successes int
+			for i := 0; i < n; i++ {
+				r := pool.Roll(c)
+				if r.Symbols["success"] > 0 && r.Symbols["failure"] > 0 {
+					t.Fatalf("success and failure should cancel: %v", r.Symbols)
+				}
+				if r.Symbols["success"] >= 1 {
+					successes++
+				}
+			}
+
+			var total float64
+			for _, o := range odds.Outcomes {
+				total += o.Chance
+			}
+			if math.Abs(total-100) > 1e-9 {
+				t.Errorf("outcomes sum to %v", total)
+			}
+			if got, want := float64(successes)/n*100, odds.AtLeast("success", 1); math.Abs(got-want) > 0.5 {
+				t.Errorf("P(success) = %.2f%%, odds give %.2f%%", got, want)
+			}
+		})
+	}
+}
+
+func TestFacedDieRoll(t *testing.T) {
+	die := NewFacedDie(Face[string]{Payload: "sword"}, Face[string]{Payload: "shield"})
+	c := NewIntSource("test-seed").SaltCustomWeighted("faces", IntWeights{2: 1})
+	for i := 0; i < 10; i++ {
+		if f := die.Roll(c); f.Payload != "shield" {
+			t.Fatalf("rolled %q with all weight on face 2", f.Payload)
+		}
+	}
+	if odds := die.Odds(c); odds[2] != 100 {
+		t.Errorf("face odds %v", odds)
+	}
+}
@@ -0,0 +1,239 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// FloatField is a coordinate-keyed field for float64 values
+type FloatField = Field[float64]
+
+// IntField is a coordinate-keyed field for int values
+type IntField = Field[int]
+
+// Field derives stateless randomness from seed+salt and integer coordinates
+// Every lookup hashes (seed, salt, coordinates) with keyed BLAKE3, so values can be
+// read in any order, concurrently, without iterating a stream
+// From V3, casters, Uint64, Float64 and each noise hash under their own key, so they are independent
+type Field[T constraint] struct {
+	key        hashKey
+	uintKey    hashKey
+	floatKey   hashKey
+	valueKey   hashKey
+	gradKey    hashKey
+	cfg        distConfig[T]
+	tickets    []ticket[T]
+	floatRange func(Range[T]) FloatRange
+	convert    func(float64) T
+}
+
+// Field creates a coordinate-keyed Field from seed+salt
+// The field inherits the source options; Correlation is ignored since lookups are stateless
+// Before V3, Uint64, Float64 and both noises share the caster key
+func (s *Source[T]) Field(salt string) *Field[T] {
+	cfg := distConfigFromOptions(s.opts)
+	cfg.Correlation = 0
+
+	key := newHashKey(s.saltKey(salt))
+	f := &Field[T]{
+		key:        key,
+		uintKey:    key,
+		floatKey:   key,
+		valueKey:   key,
+		gradKey:    key,
+		cfg:        cfg,
+		tickets:    ticketsFromWeights(s.opts.Custom),
+		floatRange: s.floatRange,
+		convert:    s.convert,
+	}
+	if s.version >= V3 {
+		f.uintKey = key.label("field uint64")
+		f.floatKey = key.label("field float64")
+		f.valueKey = key.label("field value noise")
+		f.gradKey = key.label("field gradient noise")
+	}
+	return f
+}
+
+// Dist sets the distribution
+func (f *Field[T]) Dist(d Distribution) *Field[T] {
+	if d != nil {
+		f.cfg.Dist = d
+	}
+	return f
+}
+
+// Weight sets the weight option
+func (f *Field[T]) Weight(w float64) *Field[T] {
+	f.cfg.Weight = w
+	return f
+}
+
+// Custom sets the custom weights used by Weighted and WeightedAt
+// Does nothing if w is nil
+func (f *Field[T]) Custom(w Weights[T]) *Field[T] {
+	if len(w) > 0 {
+		f.tickets = ticketsFromWeights(w)
+	}
+	return f
+}
+
+// Uint64 returns a raw 64-bit hash at the coordinates
+func (f *Field[T]) Uint64(coords ...int64) uint64 {
+	return f.uintKey.uint64(coords)
+}
+
+// Float64 returns a uniform value in [0, 1) at the coordinates
+func (f *Field[T]) Float64(coords ...int64) float64 {
+	return unitFloat(f.floatKey.uint64(coords))
+}
+
+// At returns a DistCaster whose RNG is keyed by the coordinates
+// The caster supports explosions and Odds like any other DistCaster
+// A one-dimensional Field lookup matches indexed rolls: Field(salt).At(i) == SaltDist(salt).At(i)
+func (f *Field[T]) At(coords ...int64) *DistCaster[T] {
+	src, key := f.key.derive(coords)
+	return &DistCaster[T]{
+		rng:        rand.New(src),
+		src:        src,
+		key:        key,
+		cfg:        f.cfg,
+		floatRange: f.floatRange,
+		convert:    f.convert,
+	}
+}
+
+// WeightedAt returns a WeightedCaster whose RNG is keyed by the coordinates
+func (f *Field[T]) WeightedAt(coords ...int64) *WeightedCaster[T] {
+	src, key := f.key.derive(coords)
+	return &WeightedCaster[T]{
+		rng: rand.New(src),
+		src: src,
+		key: key,
+		cfg: weightedConfig[T]{config: f.cfg.config, tickets: f.tickets},
+	}
+}
+
+// One rolls a single value at the coordinates using the field distribution
+func (f *Field[T]) One(r Range[T], coords ...int64) Result[T] {
+	return f.At(coords...).One(r)
+}
+
+// Weighted rolls a single value at the coordinates using the field custom weights
+func (f *Field[T]) Weighted(coords ...int64) Result[T] {
+	return f.WeightedAt(coords...).One()
+}
+
+// Odds calculates the probability distribution of a single lookup
+// Identical at every coordinate
+func (f *Field[T]) Odds(r ...Range[T]) Odds {
+	c := DistCaster[T]{cfg: f.cfg, floatRange: f.floatRange, convert: f.convert}
+	return c.Odds(r...)
+}
+
+// ValueNoise samples smooth value noise in [0, 1) at a point in 1 to 4 dimensions
+// Lattice values are uniform in [0, 1) at the integer corners and are blended with a quintic fade
+func (f *Field[T]) ValueNoise(x ...float64) float64 {
+	return f.noise(x, func(corner []int64, _ []float64) float64 {
+		return f.latticeValue(corner)
+	})
+}
+
+// latticeValue returns the value noise at an integer corner
+func (f *Field[T]) latticeValue(corner []int64) float64 {
+	return unitFloat(f.valueKey.uint64(corner))
+}
+
+// unitFloat maps a 64-bit hash to a uniform value in [0, 1)
+func unitFloat(h uint64) float64 {
+	return float64(h>>11) / (1 << 53)
+}
+
+// GradientNoise samples smooth gradient (Perlin-style) noise in [-1, 1] at a point in 1 to 4 dimensions
+// Each lattice corner holds a pseudo-random unit gradient; the noise is zero on the lattice
+func (f *Field[T]) GradientNoise(x ...float64) float64 {
+	scale := 2 / math.Sqrt(float64(len(x)))
+	v := f.noise(x, func(corner []int64, offset []float64) float64 {
+		h := f.gradKey.sum(corner)
+		var dot, norm float64
+		for i := range offset {
+			// Spread 16 bits of the upper hash half per axis into [-1, 1]
+			g := float64(uint16(h[32+2*i])|uint16(h[33+2*i])<<8)/32767.5 - 1
+			dot += g * offset[i]
+			norm += g * g
+		}
+		if norm == 0 {
+			return 0
+		}
+		return dot / math.Sqrt(norm)
+	})
+	return max(-1, min(1, v*scale))
+}
+
+// maxNoiseDims bounds the dimensions supported by the noise functions
+const maxNoiseDims = 4
+
+// noise interpolates corner values around x with a quintic fade
+// corner receives the lattice coordinates and the offset of x from them
+func (f *Field[T]) noise(x []float64, corner func([]int64, []float64) float64) float64 {
+	dims := len(x)
+	if dims == 0 || dims > maxNoiseDims {
+		panic("field: noise supports 1 to 4 dimensions")
+	}
+
+	var base [maxNoiseDims]int64
+	var frac, fade [maxNoiseDims]float64
+	for i, v := range x {
+		fl := math.Floor(v)
+		base[i] = int64(fl)
+		frac[i] = v - fl
+		fade[i] = quinticFade(frac[i])
+	}
+
+	var coords [maxNoiseDims]int64
+	var offset [maxNoiseDims]float64
+	var total float64
+	for mask := 0; mask < 1<<dims; mask++ {
+		weight := 1.0
+		for i := 0; i < dims; i++ {
+			if mask&(1<<i) != 0 {
+				coords[i] = base[i] + 1
+				offset[i] = frac[i] - 1
+				weight *= fade[i]
+			} else {
+				coords[i] = base[i]
+				offset[i] = frac[i]
+				weight *= 1 - fade[i]
+			}
+		}
+		total += weight * corner(coords[:dims], offset[:dims])
+	}
+	return total
+}
+
+// quinticFade is the smootherstep curve 6t^5 - 15t^4 + 10t^3
+func quinticFade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
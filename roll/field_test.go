@@ -0,0 +1,115 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+
+	"lukechampine.com/blake3"
+)
+
+func TestFieldKeyedHash(t *testing.T) {
+	key := newHashKey(NewIntSource("test-seed").saltKey("test-salt"))
+
+	for _, n := range []int{0, 1, 3, maxChunkWords, maxChunkWords + 1, 3 * maxChunkWords} {
+		words := make([]int64, n)
+		msg := make([]byte, 0, n*8)
+		for i := range words {
+			words[i] = int64(i*7919) - 1000
+			msg = append(msg, byte(words[i]), byte(words[i]>>8), byte(words[i]>>16), byte(words[i]>>24),
+				byte(words[i]>>32), byte(words[i]>>40), byte(words[i]>>48), byte(words[i]>>56))
+		}
+
+		h := blake3.New(64, key.bytes[:])
+		h.Write(msg)
+		want := h.Sum(nil)
+
+		if got := key.sum(words); string(got[:]) != string(want) {
+			t.Errorf("%d words: keyed hash mismatch", n)
+		}
+	}
+}
+
+func TestFieldStateless(t *testing.T) {
+	field := NewIntSource("test-seed").Dist(Normal()).Field("terrain")
+	again := NewIntSource("test-seed").Dist(Normal()).Field("terrain")
+
+	for x := int64(-5); x < 5; x++ {
+		for y := int64(-5); y < 5; y++ {
+			a := field.One(D100(), x, y, 7)
+			b := again.One(D100(), x, y, 7)
+			if a.First != b.First {
+				t.Fatalf("(%d, %d): got %d and %d for the same coordinates", x, y, a.First, b.First)
+			}
+		}
+	}
+
+	if field.Uint64(1, 2) == field.Uint64(2, 1) || field.Uint64(1, 2) == field.Uint64(1, 2, 0) {
+		t.Error("distinct coordinates should hash differently")
+	}
+
+	// From V3, outputs at the same coordinates come from separate keys
+	if unitFloat(field.Uint64(4)) != field.Float64(4) {
+		t.Error("V1 fields should keep a shared key")
+	}
+	v3 := NewIntSource("test-seed").Version(V3).Field("terrain")
+	raw := v3.Uint64(4)
+	if unitFloat(raw) == v3.Float64(4) || unitFloat(raw) == v3.latticeValue([]int64{4}) || v3.Float64(4) == v3.latticeValue([]int64{4}) {
+		t.Error("V3 field outputs should be independent of each other")
+	}
+	if v3.At(4).One(D100()).First != NewIntSource("test-seed").Version(V3).SaltDist("terrain").At(4).One(D100()).First {
+		t.Error("V3 field casters should still match indexed rolls")
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { field.Float64(1, 2, 3) }); allocs != 0 {
+		t.Errorf("Float64 allocated %.0f times per call", allocs)
+	}
+}
+
+func TestFieldNoise(t *testing.T) {
+	field := NewFloatSource("test-seed").Field("noise")
+
+	for x := int64(-3); x <= 3; x++ {
+		for y := int64(-3); y <= 3; y++ {
+			if got, want := field.ValueNoise(float64(x), float64(y)), field.latticeValue([]int64{x, y}); got != want {
+				t.Errorf("value noise at lattice (%d, %d): got %f, want %f", x, y, got, want)
+			}
+			if got := field.GradientNoise(float64(x), float64(y)); got != 0 {
+				t.Errorf("gradient noise at lattice (%d, %d): got %f, want 0", x, y, got)
+			}
+		}
+	}
+
+	const step = 1e-3
+	for i := 0; i < 10_000; i++ {
+		x, y := float64(i)*0.0137-60, float64(i)*0.0071-30
+		v, g := field.ValueNoise(x, y), field.GradientNoise(x, y)
+		if v < 0 || v >= 1 || g < -1 || g > 1 {
+			t.Fatalf("noise out of bounds at (%f, %f): value %f, gradient %f", x, y, v, g)
+		}
+		if math.Abs(field.ValueNoise(x+step, y)-v) > 0.01 || math.Abs(field.GradientNoise(x+step, y)-g) > 0.01 {
+			t.Fatalf("noise not smooth at (%f, %f)", x, y)
+		}
+	}
+}
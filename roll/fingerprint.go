@@ -0,0 +1,208 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"lukechampine.com/blake3"
+)
+
+// Fingerprint is a compact digest of a caster's RNG state and config, for desync detection
+// Peers that roll in lockstep can exchange fingerprints every tick: equal fingerprints mean
+// the casters are at the same point of the same stream with the same config
+type Fingerprint struct {
+	// Rolls is the number of results rolled so far (reroll and explosion draws are not counted)
+	Rolls uint64
+
+	// Hash digests the RNG state, the roll counter and the config
+	Hash uint64
+}
+
+// String returns the fingerprint in a compact form suited for logs and wire messages
+func (f Fingerprint) String() string {
+	return fmt.Sprintf("%d:%016x", f.Rolls, f.Hash)
+}
+
+// Fingerprint returns the digest of the current RNG state, config and roll counter
+// Built-in distributions are identified with their parameters (beta shapes at the configured weight,
+// tables, combinator weights and components); custom distributions by their type, and by their
+// String if they implement fmt.Stringer
+func (c *DistCaster[T]) Fingerprint() Fingerprint {
+	fp := newFingerprinter(c.src, c.rolls)
+	appendConfig(fp, c.cfg.config)
+	fp.string(distName(c.cfg.Dist, c.cfg.Weight))
+	fp.float(c.cfg.Weight)
+	fp.float(c.cfg.Correlation)
+	fp.bool(c.cfg.Strict)
+	fp.bool(c.primed)
+	fp.float(c.latent)
+	return fp.sum(c.rolls)
+}
+
+// Fingerprint returns the digest of the current RNG state, config and roll counter
+func (c *WeightedCaster[T]) Fingerprint() Fingerprint {
+	fp := newFingerprinter(c.src, c.rolls)
+	appendConfig(fp, c.cfg.config)
+	fp.uint(uint64(len(c.cfg.tickets)))
+	for _, t := range c.cfg.tickets {
+		fp.float(float64(t.value))
+		fp.float(t.weight)
+	}
+	return fp.sum(c.rolls)
+}
+
+// FirstDivergence returns the index of the first roll that differs between two recorded
+// roll sequences, or -1 if they are identical
+// If one sequence is a prefix of the other, the length of the shorter one is returned
+func FirstDivergence[T constraint](a, b []Result[T]) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if !equalResults(a[i], b[i]) {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return n
+	}
+	return -1
+}
+
+// equalResults reports whether two results hold the same rolls and metadata
+func equalResults[T constraint](a, b Result[T]) bool {
+	return a.First == b.First &&
+		a.Last == b.Last &&
+		a.Sum == b.Sum &&
+		a.LowerExplosions == b.LowerExplosions &&
+		a.UpperExplosions == b.UpperExplosions &&
+		slices.Equal(a.Rolls, b.Rolls) &&
+		slices.Equal(a.Extras, b.Extras) &&
+		slices.Equal(a.Discarded, b.Discarded)
+}
+
+// distNamer is implemented by built-in distributions whose parameters tell apart values of one type
+type distNamer interface {
+	distName(weight float64) string
+}
+
+// distName identifies a distribution for fingerprints and journals
+func distName(d Distribution, weight float64) string {
+	switch d := d.(type) {
+	case nil:
+		return "none"
+	case BetaDist:
+		alpha, beta := d.params(weight)
+		return fmt.Sprintf("beta(%v,%v)", alpha, beta)
+	case distNamer:
+		return d.distName(weight)
+	case fmt.Stringer:
+		return fmt.Sprintf("%T(%s)", d, d.String())
+	default:
+		return fmt.Sprintf("%T", d)
+	}
+}
+
+// fingerprinter accumulates the fields of a fingerprint in a fixed binary layout
+type fingerprinter struct {
+	buf []byte
+}
+
+// newFingerprinter starts a fingerprint with the RNG state and roll counter
+func newFingerprinter(src rand.Source, rolls uint64) *fingerprinter {
+	fp := &fingerprinter{buf: make([]byte, 0, 128)}
+	fp.uint(rolls)
+	switch s := src.(type) {
+	case encoding.BinaryAppender:
+		fp.buf, _ = s.AppendBinary(fp.buf)
+	case encoding.BinaryMarshaler:
+		state, _ := s.MarshalBinary()
+		fp.buf = append(fp.buf, state...)
+	}
+	return fp
+}
+
+// appendConfig appends the reroll and explosion settings of a config
+func appendConfig[T constraint](f *fingerprinter, c config[T]) {
+	f.float(float64(c.RerollBelow))
+	f.uint(uint64(c.MaxLowerExplosions))
+	f.float(float64(c.RerollAbove))
+	f.uint(uint64(c.MaxUpperExplosions))
+	f.uint(uint64(len(c.Explosions)))
+	for _, e := range c.Explosions {
+		f.string(e.name)
+		f.uint(uint64(e.mode))
+		f.uint(uint64(e.kind))
+		f.float(float64(e.threshold))
+		f.uint(uint64(e.max))
+		f.bool(e.rolls != nil)
+		if e.rolls != nil {
+			f.float(float64(e.rolls.Lower))
+			f.float(float64(e.rolls.Upper))
+		}
+	}
+	f.uint(uint64(len(c.Rerolls)))
+	for _, r := range c.Rerolls {
+		f.string(r.name)
+		f.uint(uint64(r.keep))
+		f.uint(uint64(r.times))
+	}
+}
+
+// uint appends an unsigned integer
+func (f *fingerprinter) uint(v uint64) {
+	f.buf = binary.LittleEndian.AppendUint64(f.buf, v)
+}
+
+// float appends the bits of a float
+func (f *fingerprinter) float(v float64) {
+	f.uint(math.Float64bits(v))
+}
+
+// bool appends a boolean
+func (f *fingerprinter) bool(v bool) {
+	if v {
+		f.buf = append(f.buf, 1)
+	} else {
+		f.buf = append(f.buf, 0)
+	}
+}
+
+// string appends a length-prefixed string
+func (f *fingerprinter) string(s string) {
+	f.buf = binary.AppendUvarint(f.buf, uint64(len(s)))
+	f.buf = append(f.buf, s...)
+}
+
+// sum hashes the accumulated fields into a fingerprint
+func (f *fingerprinter) sum(rolls uint64) Fingerprint {
+	h := blake3.Sum256(f.buf)
+	return Fingerprint{
+		Rolls: rolls,
+		Hash:  binary.LittleEndian.Uint64(h[:8]),
+	}
+}
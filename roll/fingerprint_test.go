@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import "testing"
+
+func TestFingerprintTracksState(t *testing.T) {
+	src := NewIntSource("test-seed")
+	a, b := src.SaltDist("salt"), src.SaltDist("salt")
+
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Fatal("fresh casters on the same salt should match")
+	}
+
+	a.One(D20())
+	if a.Fingerprint() == b.Fingerprint() {
+		t.Error("rolling should change the fingerprint")
+	}
+	if got := a.Fingerprint().Rolls; got != 1 {
+		t.Errorf("roll counter: got %d, want 1", got)
+	}
+
+	b.One(D20())
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Error("casters should match again after the same rolls")
+	}
+
+	b.Weight(0.9)
+	if a.Fingerprint() == b.Fingerprint() {
+		t.Error("config changes should change the fingerprint")
+	}
+	if src.SaltDist("salt").Dist(Skewed()).Fingerprint() == src.SaltDist("salt").Dist(WeightedHigh()).Fingerprint() {
+		t.Error("beta distributions should be told apart by their parameters")
+	}
+
+	w1, w2 := src.SaltCustomWeighted("w", IntWeights{1: 1, 2: 3}), src.SaltCustomWeighted("w", IntWeights{1: 1, 2: 3})
+	w1.Multiple(3)
+	w2.Multiple(3)
+	if w1.Fingerprint() != w2.Fingerprint() || w1.Fingerprint().Rolls != 3 {
+		t.Error("weighted casters should fingerprint like distribution casters")
+	}
+	if w1.Fingerprint() == w1.At(0).Fingerprint() {
+		t.Error("indexed casters should have their own fingerprint")
+	}
+}
+
+func TestFingerprintDistParams(t *testing.T) {
+	src := NewIntSource("test-seed")
+	stepA, _ := NewStepDensity([]float64{1, 2, 3})
+	stepB, _ := NewStepDensity([]float64{3, 2, 1})
+	stepC, _ := NewStepDensity([]float64{1, 2, 3})
+	pairs := map[string][2]Distribution{
+		"tables":   {stepA, stepB},
+		"mixtures": {Mixture([]float64{1, 3}, Uniform(), Normal()), Mixture([]float64{3, 1}, Uniform(), Normal())},
+		"nested":   {Mirror(Mixture([]float64{1, 1}, Skewed(), Normal())), Mirror(Mixture([]float64{1, 1}, WeightedHigh(), Normal()))},
+		"extremes": {MaxOf(Uniform(), 2), MaxOf(Uniform(), 3)},
+		"warps":    {Shift(Normal(), 0.1), Shift(Normal(), 0.2)},
+	}
+	for name, p := range pairs {
+		if src.SaltDist("salt").Dist(p[0]).Fingerprint() == src.SaltDist("salt").Dist(p[1]).Fingerprint() {
+			t.Errorf("%s with different parameters should fingerprint differently", name)
+		}
+	}
+	if src.SaltDist("salt").Dist(stepA).Fingerprint() != src.SaltDist("salt").Dist(stepC).Fingerprint() {
+		t.Error("equal tables should fingerprint alike")
+	}
+}
+
+func TestFirstDivergence(t *testing.T) {
+	a := NewIntSource("test-seed").SaltDist("salt").Multiple(10, D20())
+	b := NewIntSource("test-seed").SaltDist("salt").Multiple(10, D20())
+
+	if got := FirstDivergence(a, b); got != -1 {
+		t.Errorf("identical sequences: got %d, want -1", got)
+	}
+	if got := FirstDivergence(a, b[:7]); got != 7 {
+		t.Errorf("prefix: got %d, want 7", got)
+	}
+
+	b[4] = NewIntSource("other-seed").SaltDist("salt").One(D100())
+	b[4].First = a[4].First + 1
+	if got := FirstDivergence(a, b); got != 4 {
+		t.Errorf("divergent roll: got %d, want 4", got)
+	}
+}
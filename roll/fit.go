@@ -0,0 +1,264 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/andrei-cosmin/dixe/dist"
+	"github.com/andrei-cosmin/dixe/mathx"
+)
+
+// ErrNoData is returned when fitting without observations in the range
+var ErrNoData = errors.New("fit: no observations")
+
+const (
+	// fitBins is the number of equal-width bins used to fit float observations
+	fitBins = 50
+
+	// fitSteps is the number of golden-section steps refining the best grid weight
+	fitSteps = 50
+)
+
+// builtinDists lists the built-in distributions tried by Fit, with their free parameters
+var builtinDists = []struct {
+	name   string
+	dist   Distribution
+	params int
+}{
+	{"Uniform", Uniform(), 0},
+	{"Normal", Normal(), 1},
+	{"Skewed", Skewed(), 1},
+	{"WeightedLow", WeightedLow(), 1},
+	{"WeightedHigh", WeightedHigh(), 1},
+	{"WeightedMin", WeightedMin(), 1},
+	{"WeightedMax", WeightedMax(), 1},
+}
+
+// FitResult is a distribution fitted to observations, with goodness-of-fit scores
+type FitResult struct {
+	// Name is the name of the built-in distribution, or "Beta" for the moment-matched beta
+	Name string
+
+	// Dist is the fitted distribution
+	Dist Distribution
+
+	// Weight is the maximum likelihood weight (unused by Uniform and Beta)
+	Weight float64
+
+	// Alpha and Beta are the moment-matched parameters of the Beta fit
+	Alpha, Beta float64
+
+	// LogLikelihood is the log-likelihood of the binned observations
+	LogLikelihood float64
+
+	// AIC is the Akaike information criterion, lower is better
+	AIC float64
+
+	// ChiSquare is the chi-square goodness-of-fit statistic
+	ChiSquare float64
+
+	// PValue is the chi-square p-value, small values reject the fit
+	PValue float64
+}
+
+// Fit fits every built-in distribution and a beta distribution to the samples
+// Results are sorted by AIC, best first
+func (s *Source[T]) Fit(r Range[T], samples []T) ([]FitResult, error) {
+	counts := make(map[T]int, len(samples))
+	for _, v := range samples {
+		counts[v]++
+	}
+	return s.FitHistogram(r, counts)
+}
+
+// FitHistogram fits every built-in distribution and a beta distribution to observed counts
+// Int rolls are binned by value like Odds, float rolls into equal-width bins over the range
+// Built-ins are fitted by maximum likelihood over the weight, the beta by moment matching
+// Results are sorted by AIC, best first
+func (s *Source[T]) FitHistogram(r Range[T], counts map[T]int) ([]FitResult, error) {
+	h, err := newFitHistogram(s.floatRange(r), counts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]FitResult, 0, len(builtinDists)+1)
+	for _, b := range builtinDists {
+		var w float64
+		if b.params > 0 {
+			w = h.maximizeWeight(b.dist)
+		}
+		results = append(results, h.score(FitResult{Name: b.name, Dist: b.dist, Weight: w}, b.params))
+	}
+	if alpha, beta, ok := h.momentBeta(); ok {
+		d := NewBetaDist(func(float64) (float64, float64) { return alpha, beta })
+		results = append(results, h.score(FitResult{Name: "Beta", Dist: d, Alpha: alpha, Beta: beta}, 2))
+	}
+
+	slices.SortStableFunc(results, func(a, b FitResult) int {
+		return cmp.Compare(a.AIC, b.AIC)
+	})
+	return results, nil
+}
+
+// fitHistogram holds observations binned over a float range
+type fitHistogram struct {
+	rng    FloatRange
+	edges  []float64
+	counts []float64
+	total  float64
+}
+
+// newFitHistogram bins the counts, one bin per integer for int ranges
+func newFitHistogram[T constraint](rng FloatRange, counts map[T]int) (*fitHistogram, error) {
+	h := &fitHistogram{rng: rng}
+	_, discrete := any(counts).(map[int]int)
+	bins := fitBins
+	if discrete {
+		bins = int(rng.Upper - rng.Lower)
+	}
+	if bins < 1 {
+		return nil, fmt.Errorf("fit: empty range [%v, %v)", rng.Lower, rng.Upper)
+	}
+
+	h.edges = make([]float64, bins+1)
+	for i := range h.edges {
+		h.edges[i] = rng.Lower + (rng.Upper-rng.Lower)*float64(i)/float64(bins)
+	}
+	h.counts = make([]float64, bins)
+	for v, n := range counts {
+		x := float64(v)
+		if x < rng.Lower || x >= rng.Upper {
+			return nil, fmt.Errorf("fit: observation %v outside range [%v, %v)", v, rng.Lower, rng.Upper)
+		}
+		bin := min(bins-1, int((x-rng.Lower)/(rng.Upper-rng.Lower)*float64(bins)))
+		h.counts[bin] += float64(n)
+		h.total += float64(n)
+	}
+	if h.total == 0 {
+		return nil, ErrNoData
+	}
+	return h, nil
+}
+
+// probabilities returns the bin probabilities of the distribution
+func (h *fitHistogram) probabilities(d Distribution, w float64) []float64 {
+	p := FloatParams{Range: h.rng, Weight: w}
+	probs := make([]float64, len(h.counts))
+	prev := d.CDF(h.edges[0], p)
+	for i := range probs {
+		next := d.CDF(h.edges[i+1], p)
+		probs[i] = max(0, next-prev)
+		prev = next
+	}
+	return probs
+}
+
+// logLikelihood returns the multinomial log-likelihood of the counts
+func (h *fitHistogram) logLikelihood(d Distribution, w float64) float64 {
+	var ll float64
+	for i, p := range h.probabilities(d, w) {
+		if h.counts[i] > 0 {
+			ll += h.counts[i] * math.Log(max(p, math.SmallestNonzeroFloat64))
+		}
+	}
+	return ll
+}
+
+// maximizeWeight finds the maximum likelihood weight, scanning a grid and refining the best step
+func (h *fitHistogram) maximizeWeight(d Distribution) float64 {
+	best, bestLL := 0.0, math.Inf(-1)
+	for i := 0; i <= calibrateGrid; i++ {
+		w := float64(i) / calibrateGrid
+		if ll := h.logLikelihood(d, w); ll > bestLL {
+			best, bestLL = w, ll
+		}
+	}
+
+	// Golden-section search around the best grid point
+	lo, hi := max(0, best-1.0/calibrateGrid), min(1, best+1.0/calibrateGrid)
+	ratio := (math.Sqrt(5) - 1) / 2
+	for i := 0; i < fitSteps; i++ {
+		a, b := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+		if h.logLikelihood(d, a) >= h.logLikelihood(d, b) {
+			hi = b
+		} else {
+			lo = a
+		}
+	}
+	if w := (lo + hi) / 2; h.logLikelihood(d, w) > bestLL {
+		return w
+	}
+	return best
+}
+
+// momentBeta matches a beta distribution to the mean and variance of the bin midpoints
+func (h *fitHistogram) momentBeta() (alpha, beta float64, ok bool) {
+	var mean, sq float64
+	for i, n := range h.counts {
+		x := mathx.Normalize((h.edges[i]+h.edges[i+1])/2, h.rng.Lower, h.rng.Upper)
+		mean += n * x
+		sq += n * x * x
+	}
+	mean /= h.total
+	variance := sq/h.total - mean*mean
+	if variance <= 0 || variance >= mean*(1-mean) {
+		return 0, 0, false
+	}
+	common := mean*(1-mean)/variance - 1
+	return mean * common, (1 - mean) * common, true
+}
+
+// score fills the goodness-of-fit scores of a fitted distribution with the given free parameters
+// Bins are merged while their expected count is below 5 for the chi-square test
+func (h *fitHistogram) score(f FitResult, params int) FitResult {
+	probs := h.probabilities(f.Dist, f.Weight)
+	f.LogLikelihood = h.logLikelihood(f.Dist, f.Weight)
+	f.AIC = 2*float64(params) - 2*f.LogLikelihood
+
+	var obs, exp float64
+	var bins int
+	for i, p := range probs {
+		obs += h.counts[i]
+		exp += p * h.total
+		if exp < 5 && i < len(probs)-1 {
+			continue
+		}
+		if exp > 0 {
+			f.ChiSquare += (obs - exp) * (obs - exp) / exp
+			bins++
+		} else if obs > 0 {
+			f.ChiSquare = math.Inf(1)
+		}
+		obs, exp = 0, 0
+	}
+
+	if df := bins - 1 - params; df > 0 {
+		f.PValue = dist.ChiSquared{K: float64(df)}.Survival(f.ChiSquare)
+	}
+	return f
+}
@@ -0,0 +1,128 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// findFit returns the fit of the named distribution
+func findFit(t *testing.T, fits []FitResult, name string) FitResult {
+	t.Helper()
+	for _, f := range fits {
+		if f.Name == name {
+			return f
+		}
+	}
+	t.Fatalf("no %s fit", name)
+	return FitResult{}
+}
+
+func TestFitRecoversWeight(t *testing.T) {
+	tests := []struct {
+		name   string
+		dist   Distribution
+		weight float64
+	}{
+		{"Normal", Normal(), 0.7},
+		{"WeightedLow", WeightedLow(), 0.3},
+		{"WeightedMax", WeightedMax(), 0.2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := NewIntSource("test-seed").Dist(tt.dist).Weight(tt.weight)
+			var observed []int
+			for _, r := range src.SaltDist("telemetry").Multiple(samples/5, D20()) {
+				observed = append(observed, r.First)
+			}
+
+			fits, err := src.Fit(D20(), observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f := findFit(t, fits, tt.name)
+			if math.Abs(f.Weight-tt.weight) > 0.05 {
+				t.Errorf("weight: got %.3f, want %.2f", f.Weight, tt.weight)
+			}
+			if f.PValue < 0.001 {
+				t.Errorf("the true distribution should not be rejected: p-value %v", f.PValue)
+			}
+			if fits[0].AIC > f.AIC || findFit(t, fits, "Uniform").PValue > 0.001 {
+				t.Errorf("fits should be sorted by AIC and reject a uniform shape")
+			}
+		})
+	}
+}
+
+func TestFitBetaMoments(t *testing.T) {
+	src := NewFloatSource("test-seed").Dist(NewBetaDist(func(float64) (float64, float64) { return 2, 5 }))
+	var observed []float64
+	for _, r := range src.SaltDist("telemetry").Multiple(samples/5, FloatRange{Lower: 0, Upper: 10}) {
+		observed = append(observed, r.First)
+	}
+
+	fits, err := src.Fit(FloatRange{Lower: 0, Upper: 10}, observed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := findFit(t, fits, "Beta")
+	if math.Abs(f.Alpha-2) > 0.15 || math.Abs(f.Beta-5) > 0.4 {
+		t.Errorf("beta parameters: got (%.2f, %.2f), want (2, 5)", f.Alpha, f.Beta)
+	}
+	if fits[0].Name != "Beta" {
+		t.Errorf("best fit: got %s, want Beta", fits[0].Name)
+	}
+}
+
+func TestFitUniformHasNoParameters(t *testing.T) {
+	src := NewIntSource("test-seed")
+	var observed []int
+	for _, r := range src.SaltDist("uniform").Multiple(samples, D20()) {
+		observed = append(observed, r.First)
+	}
+
+	fits, err := src.Fit(D20(), observed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := findFit(t, fits, "Uniform")
+	if f.Weight != 0 || math.Abs(f.AIC+2*f.LogLikelihood) > 1e-9 {
+		t.Errorf("uniform should have no free parameters: weight %.3f, AIC %.3f, log-likelihood %.3f", f.Weight, f.AIC, f.LogLikelihood)
+	}
+	if fits[0].Name != "Uniform" {
+		t.Errorf("best fit: got %s, want Uniform", fits[0].Name)
+	}
+}
+
+func TestFitErrors(t *testing.T) {
+	src := NewIntSource("test-seed")
+	if _, err := src.Fit(D6(), nil); !errors.Is(err, ErrNoData) {
+		t.Errorf("no samples: got %v, want ErrNoData", err)
+	}
+	if _, err := src.FitHistogram(D6(), map[int]int{7: 3}); err == nil {
+		t.Error("observations outside the range should be rejected")
+	}
+}
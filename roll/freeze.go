@@ -0,0 +1,94 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// maxWeightsSpan bounds the number of unit buckets NewWeightsDist spreads weights over
+const maxWeightsSpan = 1 << 20
+
+// Snapshot returns the odds of the range as weights in percent, one per integer bucket
+// For float casters the key of each bucket is its lower bound
+func (c *DistCaster[T]) Snapshot(r Range[T]) Weights[T] {
+	odds := c.Odds(r)
+	weights := make(Weights[T], len(odds.Probabilities))
+	for v, p := range odds.Probabilities {
+		weights[T(v)] = p
+	}
+	return weights
+}
+
+// Freeze snapshots the odds of the range into a WeightedCaster, which can then be hand-tweaked
+// The caster starts a fresh stream keyed like this one: for a caster from SaltDist(salt), it
+// rolls exactly like SaltCustomWeighted(salt, c.Snapshot(r)) with the same explosion settings
+func (c *DistCaster[T]) Freeze(r Range[T]) *WeightedCaster[T] {
+	src := rand.NewChaCha8(c.key.bytes)
+	return &WeightedCaster[T]{
+		rng: rand.New(src),
+		src: src,
+		key: c.key,
+		cfg: weightedConfig[T]{
+			config:  c.cfg.config,
+			tickets: ticketsFromWeights(c.Snapshot(r)),
+		},
+	}
+}
+
+// Weights returns a copy of the caster weights, for editing and passing back to Custom
+func (c *WeightedCaster[T]) Weights() Weights[T] {
+	weights := make(Weights[T], len(c.cfg.tickets))
+	for _, t := range c.cfg.tickets {
+		weights[t.value] = t.weight
+	}
+	return weights
+}
+
+// NewWeightsDist creates a piecewise-constant distribution from weights, usable over any range
+// Each key v weighs the unit bucket [floor(v), floor(v)+1), like Odds buckets, and the buckets
+// from the lowest to the highest key are spread evenly over the range
+// On a range matching the keys, such as D20() for keys 1 to 20, Odds reproduce the weights exactly
+// Returns an error if the weights are empty, negative, zero or span too many buckets
+func NewWeightsDist[T constraint](weights Weights[T]) (Distribution, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("weights: no weights")
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for v := range weights {
+		lo = min(lo, math.Floor(float64(v)))
+		hi = max(hi, math.Floor(float64(v)))
+	}
+	span := hi - lo + 1
+	if span > maxWeightsSpan {
+		return nil, fmt.Errorf("weights: keys span %v buckets, more than %d", span, maxWeightsSpan)
+	}
+
+	density := make([]float64, int(span))
+	for v, w := range weights {
+		density[int(math.Floor(float64(v))-lo)] += w
+	}
+	return NewStepDensity(density)
+}
@@ -0,0 +1,84 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFreeze(t *testing.T) {
+	src := NewIntSource("test-seed").Dist(Normal()).Weight(0.3)
+	caster := src.SaltDist("attack")
+
+	weights := caster.Snapshot(D20())
+	odds := caster.Odds(D20()).Probabilities
+	if len(weights) != 20 || weights[10] != odds[10] {
+		t.Fatalf("snapshot should hold the odds, got %v", weights)
+	}
+
+	frozen := caster.Freeze(D20())
+	reference := src.SaltCustomWeighted("attack", weights)
+	if i := FirstDivergence(frozen.Multiple(50), reference.Multiple(50)); i != -1 {
+		t.Errorf("frozen caster should roll like SaltCustomWeighted, diverged at %d", i)
+	}
+
+	// Hand-tweak the natural 20 and check the odds follow exactly
+	edited := frozen.Weights()
+	edited[20] = 10
+	var total float64
+	for _, w := range edited {
+		total += w
+	}
+	if got := frozen.Custom(edited).Odds().Probabilities[20]; math.Abs(got-1000/total) > 1e-9 {
+		t.Errorf("edited natural 20: got %v%%, want %v%%", got, 1000/total)
+	}
+}
+
+func TestWeightsDist(t *testing.T) {
+	weights := IntWeights{1: 1, 2: 0, 3: 2, 4: 1}
+	d, err := NewWeightsDist(weights)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	odds := NewIntSource("test-seed").Dist(d).SaltDist("odds").Odds(D4()).Probabilities
+	for v, w := range weights {
+		if want := w / 4 * 100; math.Abs(odds[v]-want) > 1e-9 {
+			t.Errorf("bucket %d: got %v%%, want %v%%", v, odds[v], want)
+		}
+	}
+
+	// Over a wider range each key covers an equal share
+	wide := NewIntSource("test-seed").Dist(d).SaltDist("odds").Odds(Dice(8)).Probabilities
+	if math.Abs(wide[5]+wide[6]-50) > 1e-9 {
+		t.Errorf("key 3 should cover the third quarter of a d8, got %v%%", wide[5]+wide[6])
+	}
+
+	if _, err := NewWeightsDist(IntWeights{}); err == nil {
+		t.Error("empty weights should be rejected")
+	}
+	if _, err := NewWeightsDist(IntWeights{1: -1, 2: 2}); err == nil {
+		t.Error("negative weights should be rejected")
+	}
+}
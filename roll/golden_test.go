@@ -0,0 +1,182 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// Golden vectors pin the outputs of every Version: the default int rolls, which every replay
+// uses, and the strict float rolls, the only floats bit-identical across platforms
+// They are append-only: a failing vector means a release would silently alter stored replays,
+// so changed outputs ship as a new Version instead of an edited vector
+const (
+	goldenSeed = "golden-seed"
+	goldenSalt = "golden-salt"
+)
+
+var goldenDists = []struct {
+	name string
+	dist Distribution
+}{
+	{"Uniform", Uniform()},
+	{"Normal", Normal()},
+	{"Skewed", Skewed()},
+	{"WeightedLow", WeightedLow()},
+	{"WeightedMin", WeightedMin()},
+	{"WeightedHigh", WeightedHigh()},
+	{"WeightedMax", WeightedMax()},
+}
+
+var goldenVectors = []struct {
+	version     Version
+	dists       map[string][]int
+	strictDists map[string][]int
+	indexed     []int
+	weighted    []int
+	floats      []uint64
+	field       uint64
+}{
+	{
+		version: V1,
+		dists: map[string][]int{
+			"Uniform":      {91, 27, 65, 82, 65, 1, 68, 98},
+			"Normal":       {70, 41, 56, 64, 56, 15, 57, 80},
+			"Skewed":       {59, 25, 97, 2, 3, 92, 1, 95},
+			"WeightedLow":  {39, 17, 60, 1, 67, 13, 13, 15},
+			"WeightedMin":  {69, 40, 7, 1, 1, 1, 15, 49},
+			"WeightedHigh": {76, 48, 45, 75, 84, 77, 92, 61},
+			"WeightedMax":  {78, 52, 100, 33, 100, 95, 100, 57},
+		},
+		strictDists: map[string][]int{
+			"Uniform":      {91, 27, 65, 82, 65, 1, 68, 98},
+			"Normal":       {70, 41, 56, 64, 56, 15, 57, 80},
+			"Skewed":       {100, 11, 78, 97, 78, 1, 82, 100},
+			"WeightedLow":  {55, 10, 30, 44, 30, 1, 32, 72},
+			"WeightedMin":  {57, 1, 17, 40, 17, 1, 20, 79},
+			"WeightedHigh": {97, 65, 87, 94, 87, 21, 88, 100},
+			"WeightedMax":  {100, 73, 100, 100, 100, 14, 100, 100},
+		},
+		indexed:  []int{64, 86, 60, 35, 9, 61, 73, 62},
+		weighted: []int{4, 2, 4, 4, 4, 1, 4, 4},
+		floats:   []uint64{0x3fe658d01a66c18a, 0x3fda0413fd6745a7, 0x3fe1d678ba4f9886, 0x3fe459cd92861e67},
+		field:    0x4add6f0084341d7f,
+	},
+	{
+		version: V2,
+		dists: map[string][]int{
+			"Uniform":      {18, 74, 25, 87, 1, 31, 84, 39},
+			"Normal":       {37, 60, 40, 67, 14, 43, 65, 46},
+			"Skewed":       {2, 90, 35, 1, 1, 19, 36, 95},
+			"WeightedLow":  {45, 45, 27, 3, 6, 7, 21, 45},
+			"WeightedMin":  {1, 79, 1, 31, 1, 1, 1, 9},
+			"WeightedHigh": {74, 50, 97, 92, 97, 78, 74, 54},
+			"WeightedMax":  {100, 99, 100, 98, 100, 100, 100, 90},
+		},
+		strictDists: map[string][]int{
+			"Uniform":      {18, 74, 25, 87, 1, 31, 84, 39},
+			"Normal":       {37, 60, 40, 67, 14, 43, 65, 46},
+			"Skewed":       {4, 90, 9, 99, 1, 16, 97, 28},
+			"WeightedLow":  {7, 36, 9, 49, 1, 12, 45, 15},
+			"WeightedMin":  {1, 27, 1, 48, 1, 1, 42, 1},
+			"WeightedHigh": {57, 91, 63, 96, 20, 68, 95, 73},
+			"WeightedMax":  {60, 100, 71, 100, 13, 79, 100, 88},
+		},
+		indexed:  []int{39, 33, 88, 41, 53, 75, 18, 28},
+		weighted: []int{2, 4, 2, 4, 1, 3, 4, 3},
+		floats:   []uint64{0x3fd734cccc9d81dc, 0x3fe2f5da753721d6, 0x3fd96993c075def6, 0x3fe546cf08411db7},
+		field:    0x4e89132168867137,
+	},
+	{
+		version: V3,
+		dists: map[string][]int{
+			"Uniform":      {18, 74, 25, 87, 1, 31, 84, 39},
+			"Normal":       {37, 60, 40, 67, 14, 43, 65, 46},
+			"Skewed":       {2, 90, 35, 1, 1, 19, 36, 95},
+			"WeightedLow":  {45, 45, 27, 3, 6, 7, 21, 45},
+			"WeightedMin":  {1, 79, 1, 31, 1, 1, 1, 9},
+			"WeightedHigh": {74, 50, 97, 92, 97, 78, 74, 54},
+			"WeightedMax":  {100, 99, 100, 98, 100, 100, 100, 90},
+		},
+		strictDists: map[string][]int{
+			"Uniform":      {18, 74, 25, 87, 1, 31, 84, 39},
+			"Normal":       {37, 60, 40, 67, 14, 43, 65, 46},
+			"Skewed":       {4, 90, 9, 99, 1, 16, 97, 28},
+			"WeightedLow":  {7, 36, 9, 49, 1, 12, 45, 15},
+			"WeightedMin":  {1, 27, 1, 48, 1, 1, 42, 1},
+			"WeightedHigh": {57, 91, 63, 96, 20, 68, 95, 73},
+			"WeightedMax":  {60, 100, 71, 100, 13, 79, 100, 88},
+		},
+		indexed:  []int{39, 33, 88, 41, 53, 75, 18, 28},
+		weighted: []int{2, 4, 2, 4, 1, 3, 4, 3},
+		floats:   []uint64{0x3fd734cccc9d81dc, 0x3fe2f5da753721d6, 0x3fd96993c075def6, 0x3fe546cf08411db7},
+		field:    0x1729863129e0805b,
+	},
+}
+
+func TestGoldenVectors(t *testing.T) {
+	for _, g := range goldenVectors {
+		src := NewIntSource(goldenSeed).Version(g.version)
+		strict := NewIntSource(goldenSeed).Version(g.version).Strict(true)
+
+		for _, d := range goldenDists {
+			c := src.Derive().Dist(d.dist).Weight(0.5).SaltDist(goldenSalt)
+			assertGolden(t, g.version, d.name, g.dists[d.name], func(int) int { return c.One(D100()).First })
+
+			sc := strict.Derive().Dist(d.dist).Weight(0.5).SaltDist(goldenSalt)
+			assertGolden(t, g.version, "strict "+d.name, g.strictDists[d.name], func(int) int { return sc.One(D100()).First })
+		}
+
+		assertGolden(t, g.version, "indexed", g.indexed, func(i int) int {
+			return src.Roll(goldenSalt, uint64(i)*1000, D100()).First
+		})
+
+		w := src.SaltCustomWeighted(goldenSalt, IntWeights{1: 1, 2: 2, 3: 3, 4: 4})
+		assertGolden(t, g.version, "weighted", g.weighted, func(int) int { return w.One().First })
+
+		f := NewFloatSource(goldenSeed).Version(g.version).Strict(true).Dist(Normal()).SaltDist(goldenSalt)
+		for i, want := range g.floats {
+			if got := math.Float64bits(f.One(FloatRange{Lower: 0, Upper: 1}).First); got != want {
+				t.Errorf("V%d strict float %d: got %#016x, want %#016x", g.version, i, got, want)
+			}
+		}
+
+		field := NewFloatSource(goldenSeed).Version(g.version).Field(goldenSalt)
+		if got := field.Uint64(1, -2, 3); got != g.field {
+			t.Errorf("V%d field: got %#016x, want %#016x", g.version, got, g.field)
+		}
+	}
+}
+
+func assertGolden(t *testing.T, v Version, name string, want []int, next func(i int) int) {
+	t.Helper()
+	got := make([]int, len(want))
+	for i := range got {
+		got[i] = next(i)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("V%d %s: got %v, want %v", v, name, got, want)
+	}
+}
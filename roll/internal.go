@@ -22,7 +22,11 @@
 
 package roll
 
-import "math"
+import (
+	"math"
+
+	"github.com/andrei-cosmin/dixe/strict"
+)
 
 // constraint is a type that can be used as a roll range
 type constraint interface {
@@ -65,3 +69,11 @@ func rollFloat(distribution Distribution, p FloatParams) float64 {
 	}
 	return value
 }
+
+// quantile inverts the distribution CDF by bisection
+// Returns the largest x in [lower, upper) found with CDF(x) <= u
+func quantile(distribution Distribution, u float64, p FloatParams) float64 {
+	return strict.Bisect(func(x float64) float64 {
+		return distribution.CDF(x, p)
+	}, u, p.Lower, p.Upper)
+}
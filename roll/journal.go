@@ -0,0 +1,345 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// Call names the caster method recorded by a journal entry
+type Call string
+
+const (
+	// CallOne records a call to One
+	CallOne Call = "one"
+
+	// CallMultiple records a call to Multiple
+	CallMultiple Call = "multiple"
+
+	// CallOdds records a call to Odds
+	CallOdds Call = "odds"
+)
+
+// Entry is a single recorded caster call
+type Entry[T constraint] struct {
+	// Call is the caster method that was called
+	Call Call `json:"call"`
+
+	// Range is the range passed to the call, nil if the caster default was used
+	Range *Range[T] `json:"range,omitempty"`
+
+	// Count is the number of rolls requested by Multiple
+	Count int `json:"count,omitempty"`
+
+	// Config describes the caster config at the time of the call, if the caster can describe it
+	Config string `json:"config,omitempty"`
+
+	// Fingerprint is the caster fingerprint before the call, if the caster has one
+	Fingerprint *Fingerprint `json:"fingerprint,omitempty"`
+
+	// Results holds the results of One (a single result) and Multiple
+	Results []Result[T] `json:"results,omitempty"`
+
+	// Odds holds the result of Odds
+	Odds *Odds `json:"odds,omitempty"`
+}
+
+// String returns the call with its arguments, e.g. "Multiple(3, 1..20)"
+func (e Entry[T]) String() string {
+	r := "default"
+	if e.Range != nil {
+		r = fmt.Sprintf("%v..%v", e.Range.Lower, e.Range.Upper)
+	}
+	switch e.Call {
+	case CallOne:
+		return fmt.Sprintf("One(%s)", r)
+	case CallMultiple:
+		return fmt.Sprintf("Multiple(%d, %s)", e.Count, r)
+	case CallOdds:
+		return fmt.Sprintf("Odds(%s)", r)
+	default:
+		return fmt.Sprintf("%s(%s)", e.Call, r)
+	}
+}
+
+// Journal is an ordered record of caster calls
+type Journal[T constraint] struct {
+	Entries []Entry[T]
+}
+
+// Results returns the results of every One and Multiple call, in order
+func (j *Journal[T]) Results() []Result[T] {
+	var results []Result[T]
+	for _, e := range j.Entries {
+		results = append(results, e.Results...)
+	}
+	return results
+}
+
+// WriteJSONL writes the journal as JSON Lines, one entry per line
+func (j *Journal[T]) WriteJSONL(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, e := range j.Entries {
+		if err := enc.Encode(e); err != nil {
+			return fmt.Errorf("journal: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReadJSONL reads a journal written by WriteJSONL
+func ReadJSONL[T constraint](r io.Reader) (*Journal[T], error) {
+	j := &Journal[T]{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<26)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var e Entry[T]
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("journal: line %d: %w", line, err)
+		}
+		j.Entries = append(j.Entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("journal: %w", err)
+	}
+	return j, nil
+}
+
+// MarshalBinary encodes the journal in a compact binary (gob) form
+func (j *Journal[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(j.Entries); err != nil {
+		return nil, fmt.Errorf("journal: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a journal encoded by MarshalBinary
+func (j *Journal[T]) UnmarshalBinary(data []byte) error {
+	var entries []Entry[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return fmt.Errorf("journal: %w", err)
+	}
+	j.Entries = entries
+	return nil
+}
+
+// Recorder wraps a caster and appends every call to a journal
+type Recorder[T constraint] struct {
+	caster  Caster[T]
+	journal *Journal[T]
+}
+
+// Record wraps a caster with a recorder writing to a new journal
+func Record[T constraint](c Caster[T]) *Recorder[T] {
+	return &Recorder[T]{caster: c, journal: &Journal[T]{}}
+}
+
+// Journal returns the journal the recorder appends to
+func (r *Recorder[T]) Journal() *Journal[T] {
+	return r.journal
+}
+
+// One rolls a single value on the wrapped caster and records it
+func (r *Recorder[T]) One(rng ...Range[T]) Result[T] {
+	e := r.entry(CallOne, rng)
+	result := r.caster.One(rng...)
+	e.Results = []Result[T]{cloneResult(result)}
+	r.journal.Entries = append(r.journal.Entries, e)
+	return result
+}
+
+// Multiple rolls multiple values on the wrapped caster and records them
+func (r *Recorder[T]) Multiple(count int, rng ...Range[T]) []Result[T] {
+	e := r.entry(CallMultiple, rng)
+	e.Count = count
+	results := r.caster.Multiple(count, rng...)
+	e.Results = make([]Result[T], len(results))
+	for i, result := range results {
+		e.Results[i] = cloneResult(result)
+	}
+	r.journal.Entries = append(r.journal.Entries, e)
+	return results
+}
+
+// Odds calculates the odds on the wrapped caster and records them
+func (r *Recorder[T]) Odds(rng ...Range[T]) Odds {
+	e := r.entry(CallOdds, rng)
+	odds := r.caster.Odds(rng...)
+	e.Odds = &Odds{
+		Probabilities:        maps.Clone(odds.Probabilities),
+		LowerExplosionChance: odds.LowerExplosionChance,
+		UpperExplosionChance: odds.UpperExplosionChance,
+		ExplosionChances:     maps.Clone(odds.ExplosionChances),
+	}
+	r.journal.Entries = append(r.journal.Entries, e)
+	return odds
+}
+
+// entry starts a journal entry, snapshotting the caster before the call
+func (r *Recorder[T]) entry(call Call, rng []Range[T]) Entry[T] {
+	e := Entry[T]{Call: call}
+	if len(rng) > 0 {
+		r := rng[0]
+		e.Range = &r
+	}
+	if d, ok := r.caster.(interface{ describe() string }); ok {
+		e.Config = d.describe()
+	}
+	if f, ok := r.caster.(interface{ Fingerprint() Fingerprint }); ok {
+		fp := f.Fingerprint()
+		e.Fingerprint = &fp
+	}
+	return e
+}
+
+// Replayer is a caster that returns the results recorded in a journal
+// Every call must match the recorded call, range and count, otherwise the replayer panics
+type Replayer[T constraint] struct {
+	journal *Journal[T]
+	next    int
+}
+
+// Replay creates a caster replaying the journal from its first entry
+func Replay[T constraint](j *Journal[T]) *Replayer[T] {
+	return &Replayer[T]{journal: j}
+}
+
+// One returns the next recorded One result
+func (p *Replayer[T]) One(r ...Range[T]) Result[T] {
+	e := p.expect(Entry[T]{Call: CallOne}, r)
+	return cloneResult(e.Results[0])
+}
+
+// Multiple returns the next recorded Multiple results
+func (p *Replayer[T]) Multiple(count int, r ...Range[T]) []Result[T] {
+	e := p.expect(Entry[T]{Call: CallMultiple, Count: count}, r)
+	results := make([]Result[T], len(e.Results))
+	for i, result := range e.Results {
+		results[i] = cloneResult(result)
+	}
+	return results
+}
+
+// Odds returns the next recorded Odds result
+func (p *Replayer[T]) Odds(r ...Range[T]) Odds {
+	e := p.expect(Entry[T]{Call: CallOdds}, r)
+	return Odds{
+		Probabilities:        maps.Clone(e.Odds.Probabilities),
+		LowerExplosionChance: e.Odds.LowerExplosionChance,
+		UpperExplosionChance: e.Odds.UpperExplosionChance,
+		ExplosionChances:     maps.Clone(e.Odds.ExplosionChances),
+	}
+}
+
+// Done returns an error if some recorded calls were not replayed
+func (p *Replayer[T]) Done() error {
+	if remaining := len(p.journal.Entries) - p.next; remaining > 0 {
+		return fmt.Errorf("replay: %d of %d calls not replayed, next is %v",
+			remaining, len(p.journal.Entries), p.journal.Entries[p.next])
+	}
+	return nil
+}
+
+// expect consumes the next entry, panicking if it does not match the call
+func (p *Replayer[T]) expect(call Entry[T], r []Range[T]) Entry[T] {
+	if len(r) > 0 {
+		rng := r[0]
+		call.Range = &rng
+	}
+	if p.next >= len(p.journal.Entries) {
+		panic(fmt.Sprintf("replay: call %d is %v, journal has only %d calls", p.next, call, len(p.journal.Entries)))
+	}
+	e := p.journal.Entries[p.next]
+	if e.Call != call.Call || e.Count != call.Count || !equalRanges(e.Range, call.Range) {
+		panic(fmt.Sprintf("replay: call %d is %v, journal recorded %v", p.next, call, e))
+	}
+	if (e.Call == CallOdds && e.Odds == nil) || (e.Call == CallOne && len(e.Results) != 1) {
+		panic(fmt.Sprintf("replay: call %d has no recorded result", p.next))
+	}
+	p.next++
+	return e
+}
+
+// equalRanges reports whether two optional ranges are equal
+func equalRanges[T constraint](a, b *Range[T]) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// cloneResult copies a result so journal entries do not alias caller slices
+func cloneResult[T constraint](r Result[T]) Result[T] {
+	r.Rolls = slices.Clone(r.Rolls)
+	r.Extras = slices.Clone(r.Extras)
+	r.Discarded = slices.Clone(r.Discarded)
+	return r
+}
+
+// describe returns the caster config for journals
+func (c *DistCaster[T]) describe() string {
+	return fmt.Sprintf("dist=%s weight=%v below=%v lower=%d above=%v upper=%d explode=%s reroll=%s correlation=%v strict=%t",
+		distName(c.cfg.Dist, c.cfg.Weight), c.cfg.Weight,
+		c.cfg.RerollBelow, c.cfg.MaxLowerExplosions, c.cfg.RerollAbove, c.cfg.MaxUpperExplosions,
+		describeExplosions(c.cfg.Explosions), describeRerolls(c.cfg.Rerolls), c.cfg.Correlation, c.cfg.Strict)
+}
+
+// describe returns the caster config for journals
+func (c *WeightedCaster[T]) describe() string {
+	weights := make([]string, len(c.cfg.tickets))
+	for i, t := range c.cfg.tickets {
+		weights[i] = fmt.Sprintf("%v:%v", t.value, t.weight)
+	}
+	return fmt.Sprintf("weights=%v below=%v lower=%d above=%v upper=%d explode=%s reroll=%s",
+		weights, c.cfg.RerollBelow, c.cfg.MaxLowerExplosions, c.cfg.RerollAbove, c.cfg.MaxUpperExplosions,
+		describeExplosions(c.cfg.Explosions), describeRerolls(c.cfg.Rerolls))
+}
+
+// describeExplosions lists explosion policies for journals
+func describeExplosions[T constraint](policies []Explosion[T]) string {
+	names := make([]string, len(policies))
+	for i, e := range policies {
+		names[i] = fmt.Sprintf("%s/%v/%d", e.name, e.mode, e.max)
+	}
+	return fmt.Sprintf("%v", names)
+}
+
+// describeRerolls lists reroll policies for journals
+func describeRerolls[T constraint](policies []Reroll[T]) string {
+	names := make([]string, len(policies))
+	for i, r := range policies {
+		names[i] = fmt.Sprintf("%s/%v/%d", r.name, r.keep, r.times)
+	}
+	return fmt.Sprintf("%v", names)
+}
@@ -0,0 +1,105 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordSession runs a fixed call sequence against a caster
+func recordSession(c IntCaster) ([]Result[int], Odds) {
+	results := []Result[int]{c.One(D20())}
+	results = append(results, c.Multiple(3, D6())...)
+	odds := c.Odds(D10())
+	return append(results, c.One()), odds
+}
+
+func TestJournalReplay(t *testing.T) {
+	caster := NewIntSource("test-seed").SaltDist("boss").RerollAbove(18).UpperExplosions(2).Explode(ExplodeOnMax[int](1))
+	rec := Record[int](caster)
+	want, wantOdds := recordSession(rec)
+
+	if got := len(rec.Journal().Entries); got != 4 {
+		t.Fatalf("entries: got %d, want 4", got)
+	}
+	if got := rec.Journal().Results(); FirstDivergence(want, got) != -1 || len(got) != len(want) {
+		t.Errorf("journal results diverged at roll %d", FirstDivergence(want, got))
+	}
+	if e := rec.Journal().Entries[0]; e.Fingerprint == nil || !strings.Contains(e.Config, "upper=2") {
+		t.Errorf("entry should snapshot the caster config, got %q", e.Config)
+	}
+
+	var jsonl bytes.Buffer
+	if err := rec.Journal().WriteJSONL(&jsonl); err != nil {
+		t.Fatal(err)
+	}
+	fromJSON, err := ReadJSONL[int](&jsonl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := rec.Journal().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromBinary Journal[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, j := range map[string]*Journal[int]{"jsonl": fromJSON, "binary": &fromBinary} {
+		replay := Replay(j)
+		got, gotOdds := recordSession(replay)
+		if FirstDivergence(want, got) != -1 {
+			t.Errorf("%s: replay diverged at roll %d", name, FirstDivergence(want, got))
+		}
+		if !reflect.DeepEqual(gotOdds, wantOdds) || gotOdds.ExplosionChances["max"] == 0 {
+			t.Errorf("%s: odds should round-trip, got %+v, want %+v", name, gotOdds, wantOdds)
+		}
+		if err := replay.Done(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestReplayDeviation(t *testing.T) {
+	rec := Record[int](NewIntSource("test-seed").SaltDist("salt"))
+	rec.One(D20())
+	rec.One(D20())
+
+	replay := Replay(rec.Journal())
+	replay.One(D20())
+	if replay.Done() == nil {
+		t.Error("Done should report unreplayed calls")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("replaying a different range should panic")
+		}
+	}()
+	replay.One(D6())
+}
@@ -0,0 +1,237 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxNotationDice bounds the dice count of a notation
+const maxNotationDice = 1000
+
+// maxNotationSpan bounds the dice count times the die span, which Keys and Odds enumerate
+const maxNotationSpan = 5000
+
+// Notation is parsed dice notation: a dice term followed by modifiers applied left to right
+// Supported: NdX (N defaults to 1), d66 and d666 (digit dice), d% (percentile digits),
+// and any number of +k, -k and *k modifiers, e.g. "3d6+2", "d66", "2d6*10+5"
+type Notation struct {
+	text   string
+	count  int
+	sides  int
+	digits *DigitDie
+	ops    []notationOp
+}
+
+// notationOp is a modifier applied to the dice total
+type notationOp struct {
+	op    byte
+	value int
+}
+
+// ParseNotation parses dice notation
+func ParseNotation(s string) (Notation, error) {
+	text := strings.ToLower(strings.Join(strings.Fields(s), ""))
+	n := Notation{text: text, count: 1}
+
+	d := strings.IndexByte(text, 'd')
+	if d < 0 {
+		return Notation{}, fmt.Errorf("notation: %q has no dice term", s)
+	}
+	if d > 0 {
+		count, err := strconv.Atoi(text[:d])
+		if err != nil || strings.ContainsAny(text[:d], "+-") || count < 1 || count > maxNotationDice {
+			return Notation{}, fmt.Errorf("notation: invalid dice count %q", text[:d])
+		}
+		n.count = count
+	}
+
+	rest := text[d+1:]
+	end := strings.IndexAny(rest, "+-*")
+	if end < 0 {
+		end = len(rest)
+	}
+	switch die := rest[:end]; die {
+	case "%":
+		n.digits = Percentile()
+	case "66":
+		n.digits = D66()
+	case "666":
+		n.digits = D666()
+	default:
+		sides, err := strconv.Atoi(die)
+		if err != nil || sides < 1 || sides > maxNotationSpan {
+			return Notation{}, fmt.Errorf("notation: invalid die %q", die)
+		}
+		n.sides = sides
+	}
+	if n.count*n.span() > maxNotationSpan {
+		return Notation{}, fmt.Errorf("notation: %q spans more than %d totals", s, maxNotationSpan)
+	}
+
+	for rest = rest[end:]; rest != ""; {
+		op := rest[0]
+		rest = rest[1:]
+		end := strings.IndexAny(rest, "+-*")
+		if end < 0 {
+			end = len(rest)
+		}
+		value, err := strconv.Atoi(rest[:end])
+		if err != nil || value < 0 {
+			return Notation{}, fmt.Errorf("notation: invalid modifier %q", string(op)+rest[:end])
+		}
+		n.ops = append(n.ops, notationOp{op: op, value: value})
+		rest = rest[end:]
+	}
+	return n, nil
+}
+
+// String returns the normalized notation
+func (n Notation) String() string {
+	return n.text
+}
+
+// Dice returns the number of dice rolled
+func (n Notation) Dice() int {
+	return n.count
+}
+
+// Keys returns every total the notation can roll, in ascending order
+func (n Notation) Keys() []int {
+	var die []int
+	if n.digits != nil {
+		die = n.digits.Keys()
+	} else {
+		for v := 1; v <= n.sides; v++ {
+			die = append(die, v)
+		}
+	}
+
+	totals := map[int]bool{0: true}
+	for i := 0; i < n.count; i++ {
+		next := make(map[int]bool, len(totals)+len(die))
+		for t := range totals {
+			for _, v := range die {
+				next[t+v] = true
+			}
+		}
+		totals = next
+	}
+
+	keys := make([]int, 0, len(totals))
+	for t := range totals {
+		keys = append(keys, n.apply(t))
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// Roll rolls the notation with the caster
+// Rolls holds each die (digit dice read as one value) and Sum the total after modifiers
+func (n Notation) Roll(c Caster[int]) Result[int] {
+	rolls := make([]int, n.count)
+	var sum int
+	for i := range rolls {
+		if n.digits != nil {
+			rolls[i] = n.digits.Roll(c).Value
+		} else {
+			rolls[i] = c.One(Dice(n.sides)).First
+		}
+		sum += rolls[i]
+	}
+	return Result[int]{
+		First: rolls[0],
+		Last:  rolls[len(rolls)-1],
+		Sum:   n.apply(sum),
+		Rolls: rolls,
+	}
+}
+
+// Odds calculates the exact probability of each total from the caster's odds (0-100%)
+func (n Notation) Odds(c Caster[int]) map[int]float64 {
+	die := make(map[int]float64)
+	if n.digits != nil {
+		for v, p := range n.digits.Odds(c) {
+			die[v] = p / 100
+		}
+	} else {
+		for v, p := range c.Odds(Dice(n.sides)).Probabilities {
+			if v >= 1 && v <= n.sides {
+				die[v] = p / 100
+			}
+		}
+	}
+
+	totals := map[int]float64{0: 1}
+	for i := 0; i < n.count; i++ {
+		next := make(map[int]float64, len(totals)+len(die))
+		for t, p := range totals {
+			for v, q := range die {
+				next[t+v] += p * q
+			}
+		}
+		totals = next
+	}
+
+	odds := make(map[int]float64, len(totals))
+	for t, p := range totals {
+		odds[n.apply(t)] += p * 100
+	}
+	return odds
+}
+
+// Pool creates a success pool of the notation's dice, rolled with the caster
+// Panics for digit dice, which have no contiguous range
+func (n Notation) Pool(c *DistCaster[int]) *Pool[int] {
+	if n.digits != nil {
+		panic("notation: digit dice cannot form a success pool")
+	}
+	return c.Pool(n.count, Dice(n.sides))
+}
+
+// span returns the number of values between the lowest and highest face of one die
+func (n Notation) span() int {
+	if n.digits != nil {
+		keys := n.digits.Keys()
+		return slices.Max(keys) - slices.Min(keys) + 1
+	}
+	return n.sides
+}
+
+// apply applies the modifiers to a total
+func (n Notation) apply(total int) int {
+	for _, o := range n.ops {
+		switch o.op {
+		case '+':
+			total += o.value
+		case '-':
+			total -= o.value
+		case '*':
+			total *= o.value
+		}
+	}
+	return total
+}
@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseNotation(t *testing.T) {
+	valid := []string{"d6", "3d6", "2d6+3", "1d20-1", "d66", "D666", "d%", "2d6 * 10 + 5", "1000d5", "50d%", "d5000"}
+	for _, s := range valid {
+		if _, err := ParseNotation(s); err != nil {
+			t.Errorf("ParseNotation(%q): %v", s, err)
+		}
+	}
+	invalid := []string{"", "6", "0d6", "d0", "2d", "dx", "2d6+", "2d6/2", "+2d6", "2d6+-1", "1d1000000000", "d5001", "200d%", "1000d6", "10d666"}
+	for _, s := range invalid {
+		if _, err := ParseNotation(s); err == nil {
+			t.Errorf("ParseNotation(%q) should fail", s)
+		}
+	}
+}
+
+func TestNotationOdds(t *testing.T) {
+	c := NewIntSource("test-seed").SaltDist("notation")
+
+	n := mustNotation(t, "2d6*10+5")
+	odds := n.Odds(c)
+	if len(odds) != 11 || math.Abs(odds[75]-100.0/6) > 1e-9 {
+		t.Errorf("2d6*10+5: %d totals, P(75) = %v", len(odds), odds[75])
+	}
+
+	pct := mustNotation(t, "d%")
+	if odds := pct.Odds(c); len(odds) != 100 || math.Abs(odds[100]-1) > 1e-9 {
+		t.Errorf("d%%: %d totals, P(100) = %v", len(odds), odds[100])
+	}
+
+	biased := NewIntSource("test-seed").Dist(Normal()).Weight(0.5).SaltDist("notation")
+	n = mustNotation(t, "3d6+2")
+	odds = n.Odds(biased)
+	const count = 100_000
+	totals := make(map[int]int)
+	for i := 0; i < count; i++ {
+		r := n.Roll(biased)
+		if len(r.Rolls) != 3 || r.Sum != r.Rolls[0]+r.Rolls[1]+r.Rolls[2]+2 {
+			t.Fatalf("inconsistent result %+v", r)
+		}
+		totals[r.Sum]++
+	}
+	for v, p := range odds {
+		if got := float64(totals[v]) / count * 100; math.Abs(got-p) > 0.5 {
+			t.Errorf("P(%d) = %.2f%%, odds give %.2f%%", v, got, p)
+		}
+	}
+
+	pool := mustNotation(t, "6d10").Pool(c).Target(8)
+	if len(pool.Roll().Dice) != 6 {
+		t.Error("notation pool should roll 6 dice")
+	}
+}
+
+func mustNotation(t *testing.T, s string) Notation {
+	t.Helper()
+	n, err := ParseNotation(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestNotationKeys(t *testing.T) {
+	c := NewIntSource("test-seed").SaltDist("keys")
+	for _, s := range []string{"2d6", "d66", "d%", "3d4*5-2"} {
+		n := mustNotation(t, s)
+		keys, odds := n.Keys(), n.Odds(c)
+		if len(keys) != len(odds) {
+			t.Errorf("%s: %d keys, %d totals with odds", s, len(keys), len(odds))
+		}
+		for _, k := range keys {
+			if odds[k] == 0 {
+				t.Errorf("%s: key %d has no odds", s, k)
+			}
+		}
+	}
+}
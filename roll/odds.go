@@ -33,4 +33,8 @@ type Odds struct {
 
 	// UpperExplosionChance is the probability of triggering an upper explosion per roll (0-100%)
 	UpperExplosionChance float64
+
+	// ExplosionChances maps each explosion policy name to its chance of triggering on the first roll (0-100%)
+	// Float DistCasters leave out predicate policies, whose chance cannot be computed exactly
+	ExplosionChances map[string]float64
 }
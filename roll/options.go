@@ -67,6 +67,24 @@ type Options[T constraint] struct {
 
 	// MaxUpperExplosions limits recursive explosions (default 0 - none)
 	MaxUpperExplosions int
+
+	// Explosions are explosion policies applied after the RerollBelow/RerollAbove chains
+	Explosions []Explosion[T]
+
+	// Rerolls are reroll policies replacing the first roll, applied before explosions
+	Rerolls []Reroll[T]
+
+	// Correlation links successive DistCaster rolls through a Gaussian AR(1) copula [-1.0, 1.0]:
+	//   0:        independent rolls (default)
+	//   positive: successive rolls drift gradually (higher = longer memory)
+	//   negative: successive rolls tend to alternate around the center
+	// Each roll still follows Dist and Weight, so Odds are unaffected
+	Correlation float64
+
+	// Strict makes DistCaster sampling and CDFs bit-identical on every platform
+	// Built-in distributions switch to fixed-operation implementations (see StrictDistribution);
+	// sampling is slower since it inverts the CDF
+	Strict bool
 }
 
 // DefaultOptions returns sensible defaults for RollOptions
@@ -78,6 +96,8 @@ func DefaultOptions[T constraint]() Options[T] {
 		MaxLowerExplosions: 0,
 		RerollAbove:        0,
 		MaxUpperExplosions: 0,
+		Correlation:        0,
+		Strict:             false,
 	}
 }
 
@@ -104,6 +124,18 @@ func (o *Options[T]) MergeWith(override Options[T]) {
 	if override.MaxUpperExplosions != 0 {
 		o.MaxUpperExplosions = override.MaxUpperExplosions
 	}
+	if len(override.Explosions) > 0 {
+		o.Explosions = override.Explosions
+	}
+	if len(override.Rerolls) > 0 {
+		o.Rerolls = override.Rerolls
+	}
+	if override.Correlation != 0 {
+		o.Correlation = override.Correlation
+	}
+	if override.Strict {
+		o.Strict = override.Strict
+	}
 }
 
 // MergeOptions merges multiple RollOptions structs into a single one
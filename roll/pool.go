@@ -0,0 +1,289 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+// FloatPool is a success pool of float64 dice
+type FloatPool = Pool[float64]
+
+// IntPool is a success pool of int dice
+type IntPool = Pool[int]
+
+// Pool counts successes over a pool of dice instead of summing them
+// Each die is rolled with the caster's One and counts its first value, so the caster's
+// distribution and reroll policies apply while its explosion settings are ignored
+type Pool[T constraint] struct {
+	caster *DistCaster[T]
+	dice   int
+	r      Range[T]
+
+	target   T
+	extra    T
+	hasExtra bool
+	botch    T
+	hasBotch bool
+	cancel   bool
+
+	explodeAt     T
+	maxExplosions int
+}
+
+// PoolResult is the outcome of a success pool roll
+type PoolResult[T constraint] struct {
+	// Dice holds every face rolled, exploded dice following the die that exploded
+	Dice []T
+
+	// Successes is the number of successes, after cancellation (never negative)
+	Successes int
+
+	// Botches is the number of botch faces among the original dice
+	Botches int
+
+	// Exploded is the number of dice added by exploding successes
+	Exploded int
+
+	// Botch is set when no die succeeded and at least one showed a botch face
+	Botch bool
+
+	// Glitch is set when botch faces show on more than half the original dice
+	Glitch bool
+
+	// CriticalGlitch is a glitch with no successes rolled
+	CriticalGlitch bool
+}
+
+// PoolOdds contains the exact outcome probabilities of a success pool
+type PoolOdds struct {
+	// Successes maps each success count to its probability (0-100%)
+	Successes map[int]float64
+
+	// Botch is the probability of a botch (0-100%)
+	Botch float64
+
+	// Glitch is the probability of a glitch (0-100%)
+	Glitch float64
+
+	// CriticalGlitch is the probability of a critical glitch (0-100%)
+	CriticalGlitch float64
+}
+
+// AtLeast returns the probability of at least k successes (0-100%)
+func (o PoolOdds) AtLeast(k int) float64 {
+	var sum float64
+	for n, p := range o.Successes {
+		if n >= k {
+			sum += p
+		}
+	}
+	return sum
+}
+
+// Pool creates a success pool of dice on the range, rolled with this caster
+// By default only the highest face succeeds; see Target
+func (c *DistCaster[T]) Pool(dice int, r Range[T]) *Pool[T] {
+	if dice < 0 {
+		panic("pool: negative dice")
+	}
+	return &Pool[T]{caster: c, dice: dice, r: r, target: r.Upper}
+}
+
+// Target sets the target number: faces at or above it are successes
+func (p *Pool[T]) Target(v T) *Pool[T] {
+	p.target = v
+	return p
+}
+
+// Extra sets faces at or above v to count one extra success
+func (p *Pool[T]) Extra(v T) *Pool[T] {
+	p.extra, p.hasExtra = v, true
+	return p
+}
+
+// Botch sets faces at or below v as botch faces, used for botches and glitches
+func (p *Pool[T]) Botch(v T) *Pool[T] {
+	p.botch, p.hasBotch = v, true
+	return p
+}
+
+// Cancel sets whether each botch face cancels a success
+func (p *Pool[T]) Cancel(on bool) *Pool[T] {
+	p.cancel = on
+	return p
+}
+
+// Explode sets faces at or above v to add another die to the pool, at most max times per die
+// Added dice count successes but not botch faces; a max of 0 or less disables explosions
+func (p *Pool[T]) Explode(v T, max int) *Pool[T] {
+	if max < 0 {
+		max = 0
+	}
+	p.explodeAt, p.maxExplosions = v, max
+	return p
+}
+
+// Roll rolls the pool
+func (p *Pool[T]) Roll() PoolResult[T] {
+	result := PoolResult[T]{Dice: make([]T, 0, p.dice)}
+	var successes int
+	for i := 0; i < p.dice; i++ {
+		face := p.caster.One(p.r).First
+		result.Dice = append(result.Dice, face)
+		successes += p.successes(face)
+		if p.isBotch(face) {
+			result.Botches++
+		}
+
+		for n := 0; n < p.maxExplosions && face >= p.explodeAt; n++ {
+			face = p.caster.One(p.r).First
+			result.Dice = append(result.Dice, face)
+			result.Exploded++
+			successes += p.successes(face)
+		}
+	}
+
+	result.Successes = p.net(successes, result.Botches)
+	result.Botch = successes == 0 && result.Botches > 0
+	result.Glitch = 2*result.Botches > p.dice
+	result.CriticalGlitch = result.Glitch && successes == 0
+	return result
+}
+
+// Multiple rolls the pool count times
+func (p *Pool[T]) Multiple(count int) []PoolResult[T] {
+	results := make([]PoolResult[T], count)
+	for i := range results {
+		results[i] = p.Roll()
+	}
+	return results
+}
+
+// Odds calculates the exact outcome probabilities from the caster's odds for one die
+// The joint distribution of successes and botch faces is convolved die by die
+// Float ranges evaluate faces per unit bucket, like DistCaster.Odds
+func (p *Pool[T]) Odds() PoolOdds {
+	faces := p.caster.Odds(p.r).Probabilities
+	values := make([]T, 0, len(faces))
+	probs := make([]float64, 0, len(faces))
+	for v := int(p.r.Lower); v <= int(p.r.Upper); v++ {
+		values = append(values, T(v))
+		probs = append(probs, faces[v]/100)
+	}
+
+	// chain[k][s] is the chance a die with k explosions left yields s successes
+	chain := make([][]float64, p.maxExplosions+1)
+	for k := range chain {
+		chain[k] = []float64{}
+		for i, v := range values {
+			tail := []float64{1}
+			if k > 0 && v >= p.explodeAt {
+				tail = chain[k-1]
+			}
+			addShifted(&chain[k], tail, p.successes(v), probs[i])
+		}
+	}
+
+	// die[b][s] is the chance an original die shows b botch faces and yields s successes
+	die := [2][]float64{}
+	for i, v := range values {
+		tail := []float64{1}
+		if p.maxExplosions > 0 && v >= p.explodeAt {
+			tail = chain[p.maxExplosions-1]
+		}
+		b := 0
+		if p.isBotch(v) {
+			b = 1
+		}
+		addShifted(&die[b], tail, p.successes(v), probs[i])
+	}
+
+	// joint[b][s] over the whole pool
+	joint := [][]float64{{1}}
+	for i := 0; i < p.dice; i++ {
+		next := make([][]float64, len(joint)+1)
+		for b, row := range joint {
+			for db, dieRow := range die {
+				for s, q := range row {
+					if q != 0 {
+						addShifted(&next[b+db], dieRow, s, q)
+					}
+				}
+			}
+		}
+		joint = next
+	}
+
+	odds := PoolOdds{Successes: make(map[int]float64)}
+	for b, row := range joint {
+		for s, q := range row {
+			if q == 0 {
+				continue
+			}
+			odds.Successes[p.net(s, b)] += q * 100
+			glitch := 2*b > p.dice
+			if s == 0 && b > 0 {
+				odds.Botch += q * 100
+			}
+			if glitch {
+				odds.Glitch += q * 100
+			}
+			if glitch && s == 0 {
+				odds.CriticalGlitch += q * 100
+			}
+		}
+	}
+	return odds
+}
+
+// successes returns the successes a single face counts
+func (p *Pool[T]) successes(v T) int {
+	n := 0
+	if v >= p.target {
+		n++
+	}
+	if p.hasExtra && v >= p.extra {
+		n++
+	}
+	return n
+}
+
+// isBotch reports whether a face is a botch face
+func (p *Pool[T]) isBotch(v T) bool {
+	return p.hasBotch && v <= p.botch
+}
+
+// net returns the successes left after cancellation
+func (p *Pool[T]) net(successes, botches int) int {
+	if p.cancel {
+		return max(successes-botches, 0)
+	}
+	return successes
+}
+
+// addShifted adds scale * src, shifted by offset, into dst
+func addShifted(dst *[]float64, src []float64, offset int, scale float64) {
+	if n := offset + len(src); n > len(*dst) {
+		*dst = append(*dst, make([]float64, n-len(*dst))...)
+	}
+	for i, q := range src {
+		(*dst)[offset+i] += scale * q
+	}
+}
@@ -0,0 +1,126 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPoolBinomialOdds(t *testing.T) {
+	odds := NewIntSource("test-seed").SaltDist("pool").Pool(5, D10()).Target(8).Odds()
+
+	// Without explosions or extras, successes follow Binomial(5, 0.3)
+	choose := []float64{1, 5, 10, 10, 5, 1}
+	for k := 0; k <= 5; k++ {
+		want := choose[k] * math.Pow(0.3, float64(k)) * math.Pow(0.7, float64(5-k)) * 100
+		if math.Abs(odds.Successes[k]-want) > 1e-9 {
+			t.Errorf("P(%d successes) = %v, want %v", k, odds.Successes[k], want)
+		}
+	}
+	if math.Abs(odds.AtLeast(0)-100) > 1e-9 {
+		t.Errorf("P(>= 0) = %v, want 100", odds.AtLeast(0))
+	}
+}
+
+func TestPoolMatchesOdds(t *testing.T) {
+	tests := []struct {
+		name string
+		pool func(c *IntDistCaster) *IntPool
+	}{
+		{"world of darkness", func(c *IntDistCaster) *IntPool {
+			return c.Pool(6, D10()).Target(8).Botch(1).Cancel(true).Explode(10, 3)
+		}},
+		{"shadowrun", func(c *IntDistCaster) *IntPool {
+			return c.Pool(4, D6()).Target(5).Botch(1).Explode(6, 5)
+		}},
+		{"exalted", func(c *IntDistCaster) *IntPool {
+			return c.Pool(5, D10()).Target(7).Extra(10)
+		}},
+	}
+
+	const n = 100_000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := tt.pool(NewIntSource("test-seed").Dist(Normal()).Weight(0.3).SaltDist(tt.name))
+			counts := make(map[int]int)
+			var botches, glitches, critical int
+			for _, r := range pool.Multiple(n) {
+				if len(r.Dice) != pool.dice+r.Exploded {
+					t.Fatalf("dice not recorded: %+v", r)
+				}
+				counts[r.Successes]++
+				if r.Botch {
+					botches++
+				}
+				if r.Glitch {
+					glitches++
+				}
+				if r.CriticalGlitch {
+					critical++
+				}
+			}
+
+			odds := pool.Odds()
+			var total float64
+			for k, p := range odds.Successes {
+				total += p
+				if got := float64(counts[k]) / n * 100; math.Abs(got-p) > 0.6 {
+					t.Errorf("P(%d successes) = %.2f%%, odds give %.2f%%", k, got, p)
+				}
+			}
+			if math.Abs(total-100) > 1e-9 {
+				t.Errorf("success odds sum to %v", total)
+			}
+			for _, c := range []struct {
+				name string
+				got  int
+				want float64
+			}{
+				{"botch", botches, odds.Botch},
+				{"glitch", glitches, odds.Glitch},
+				{"critical glitch", critical, odds.CriticalGlitch},
+			} {
+				if got := float64(c.got) / n * 100; math.Abs(got-c.want) > 0.5 {
+					t.Errorf("%s: %.2f%%, odds give %.2f%%", c.name, got, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPoolNegativeExplosions(t *testing.T) {
+	src := NewIntSource("test-seed")
+	plain := src.SaltDist("pool").Pool(5, D10()).Target(8).Odds()
+	pool := src.SaltDist("pool").Pool(5, D10()).Target(8).Explode(10, -3)
+
+	odds := pool.Odds()
+	for k, p := range plain.Successes {
+		if math.Abs(odds.Successes[k]-p) > 1e-9 {
+			t.Errorf("P(%d successes) = %v, want %v", k, odds.Successes[k], p)
+		}
+	}
+	if r := pool.Roll(); r.Exploded != 0 || len(r.Dice) != 5 {
+		t.Errorf("negative limit exploded: %+v", r)
+	}
+}
@@ -0,0 +1,202 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import "slices"
+
+// rerollKeep selects which value a reroll keeps
+type rerollKeep int
+
+const (
+	keepNew rerollKeep = iota
+	keepHigher
+	keepLower
+)
+
+// String returns the keep rule name
+func (k rerollKeep) String() string {
+	switch k {
+	case keepHigher:
+		return "higher"
+	case keepLower:
+		return "lower"
+	default:
+		return "new"
+	}
+}
+
+// Reroll is a policy replacing the first roll with a new one while it triggers
+// Unlike explosions, rerolls never add to the sum: the replaced values are kept in Result.Discarded
+// Policies run in order on the first roll, before explosions
+type Reroll[T constraint] struct {
+	name  string
+	when  func(T) bool
+	times int
+	keep  rerollKeep
+}
+
+// RerollOnce rerolls once when the predicate holds and keeps the new value,
+// e.g. RerollOnce(AtMost(2)) for Great Weapon Fighting
+func RerollOnce[T constraint](when func(T) bool) Reroll[T] {
+	return Reroll[T]{name: "once", when: when, times: 1}
+}
+
+// RerollUntil rerolls until the predicate holds, at most max times, keeping the last value
+func RerollUntil[T constraint](accept func(T) bool, max int) Reroll[T] {
+	return Reroll[T]{name: "until", when: func(v T) bool { return !accept(v) }, times: max}
+}
+
+// RerollKeepHigher rolls again when the predicate holds (always if nil) and keeps the higher value
+func RerollKeepHigher[T constraint](when func(T) bool) Reroll[T] {
+	return Reroll[T]{name: "keep higher", when: when, times: 1, keep: keepHigher}
+}
+
+// RerollKeepLower rolls again when the predicate holds (always if nil) and keeps the lower value
+func RerollKeepLower[T constraint](when func(T) bool) Reroll[T] {
+	return Reroll[T]{name: "keep lower", when: when, times: 1, keep: keepLower}
+}
+
+// Named sets the policy name
+func (r Reroll[T]) Named(name string) Reroll[T] {
+	r.name = name
+	return r
+}
+
+// Times sets how many rerolls the policy may make
+func (r Reroll[T]) Times(n int) Reroll[T] {
+	r.times = n
+	return r
+}
+
+// Name returns the policy name
+func (r Reroll[T]) Name() string {
+	return r.name
+}
+
+// Below returns a predicate matching values strictly below v
+func Below[T constraint](v T) func(T) bool {
+	return func(x T) bool { return x < v }
+}
+
+// Above returns a predicate matching values strictly above v
+func Above[T constraint](v T) func(T) bool {
+	return func(x T) bool { return x > v }
+}
+
+// AtMost returns a predicate matching values at most v
+func AtMost[T constraint](v T) func(T) bool {
+	return func(x T) bool { return x <= v }
+}
+
+// AtLeast returns a predicate matching values at least v
+func AtLeast[T constraint](v T) func(T) bool {
+	return func(x T) bool { return x >= v }
+}
+
+// OneOf returns a predicate matching the given values
+func OneOf[T constraint](values ...T) func(T) bool {
+	return func(x T) bool { return slices.Contains(values, x) }
+}
+
+// triggers reports whether the policy rerolls the value
+func (r Reroll[T]) triggers(v T) bool {
+	return r.when == nil || r.when(v)
+}
+
+// reroll applies the policies to the first roll, returning the kept value and the discarded ones
+func reroll[T constraint](policies []Reroll[T], first T, draw func() T) (T, []T) {
+	var discarded []T
+	current := first
+	for _, r := range policies {
+		for n := 0; n < r.times && r.triggers(current); n++ {
+			next := draw()
+			switch {
+			case r.keep == keepHigher && next <= current, r.keep == keepLower && next >= current:
+				discarded = append(discarded, next)
+			default:
+				discarded = append(discarded, current)
+				current = next
+			}
+		}
+	}
+	return current, discarded
+}
+
+// rerollProbabilities adjusts the probabilities of sorted values for the policies exactly
+// Each reroll draws from base, the probabilities of a single roll
+func rerollProbabilities[T constraint](policies []Reroll[T], values []T, base []float64) []float64 {
+	probs := slices.Clone(base)
+	next := make([]float64, len(probs))
+	for _, r := range policies {
+		for n := 0; n < r.times; n++ {
+			var triggered float64
+			for i, v := range values {
+				if r.triggers(v) {
+					triggered += probs[i]
+				}
+			}
+			if triggered == 0 {
+				break
+			}
+
+			switch r.keep {
+			case keepNew:
+				// Kept values stay, rerolled mass is redistributed like a fresh roll
+				for i, v := range values {
+					next[i] = triggered * base[i]
+					if !r.triggers(v) {
+						next[i] += probs[i]
+					}
+				}
+			case keepHigher:
+				// max(a, X) is v when X = v > a, or when a = v and X <= v
+				var below, cumulative float64
+				for i, v := range values {
+					cumulative += base[i]
+					next[i] = below * base[i]
+					if r.triggers(v) {
+						next[i] += probs[i] * cumulative
+						below += probs[i]
+					} else {
+						next[i] += probs[i]
+					}
+				}
+			case keepLower:
+				// min(a, X) is v when X = v < a, or when a = v and X >= v
+				var above, cumulative float64
+				for i := len(values) - 1; i >= 0; i-- {
+					cumulative += base[i]
+					next[i] = above * base[i]
+					if r.triggers(values[i]) {
+						next[i] += probs[i] * cumulative
+						above += probs[i]
+					} else {
+						next[i] += probs[i]
+					}
+				}
+			}
+			probs, next = next, probs
+		}
+	}
+	return probs
+}
@@ -0,0 +1,135 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package roll
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRerollOdds(t *testing.T) {
+	odds := NewIntSource("test-seed").SaltDist("gwf").Reroll(RerollOnce(AtMost(2))).Odds(D6())
+	for v := 1; v <= 6; v++ {
+		want := 100.0 * 4 / 18
+		if v <= 2 {
+			want = 100.0 / 18
+		}
+		if math.Abs(odds.Probabilities[v]-want) > 1e-9 {
+			t.Errorf("P(%d) = %v, want %v", v, odds.Probabilities[v], want)
+		}
+	}
+
+	src := NewIntSource("test-seed")
+	keep := src.SaltDist("keep").Reroll(RerollKeepHigher[int](nil)).Odds(D20())
+	advantage := src.Derive().Dist(MaxOf(Uniform(), 2)).SaltDist("keep").Odds(D20())
+	for v := 1; v <= 20; v++ {
+		if math.Abs(keep.Probabilities[v]-advantage.Probabilities[v]) > 1e-9 {
+			t.Errorf("keep higher P(%d) = %v, advantage gives %v", v, keep.Probabilities[v], advantage.Probabilities[v])
+		}
+	}
+}
+
+func TestRerollMatchesOdds(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []Reroll[int]
+	}{
+		{"once", []Reroll[int]{RerollOnce(OneOf(1, 2))}},
+		{"until", []Reroll[int]{RerollUntil(AtLeast(4), 2)}},
+		{"keep higher", []Reroll[int]{RerollKeepHigher(Below(5))}},
+		{"keep lower", []Reroll[int]{RerollKeepLower(Above(3)).Times(2)}},
+		{"chained", []Reroll[int]{RerollOnce(AtMost(1)), RerollKeepHigher(AtMost(3))}},
+	}
+
+	const n = 200_000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caster := NewIntSource("test-seed").Dist(Normal()).Weight(0.4).SaltDist(tt.name).Reroll(tt.policies...)
+			counts := make(map[int]int)
+			for _, r := range caster.Multiple(n, D6()) {
+				counts[r.First]++
+				if r.Sum != r.First || len(r.Rolls) != 1 {
+					t.Fatalf("rerolls should not add to the sum: %+v", r)
+				}
+			}
+			odds := caster.Odds(D6())
+			for v := 1; v <= 6; v++ {
+				got := float64(counts[v]) / n * 100
+				if math.Abs(got-odds.Probabilities[v]) > 0.5 {
+					t.Errorf("P(%d) = %.2f%%, odds give %.2f%%", v, got, odds.Probabilities[v])
+				}
+			}
+		})
+	}
+}
+
+func TestRerollDiscarded(t *testing.T) {
+	caster := NewIntSource("test-seed").SaltDist("discarded").Reroll(RerollKeepHigher(AtMost(10)))
+	var rerolled int
+	for _, r := range caster.Multiple(1000, D20()) {
+		if len(r.Discarded) > 1 {
+			t.Fatalf("keep higher should discard at most one value: %+v", r)
+		}
+		if len(r.Discarded) == 1 {
+			rerolled++
+			if r.Discarded[0] > r.First {
+				t.Fatalf("kept %d over the higher %d", r.First, r.Discarded[0])
+			}
+		}
+	}
+	if rerolled == 0 {
+		t.Error("expected some rerolls")
+	}
+
+	until := NewIntSource("test-seed").SaltDist("until").Reroll(RerollUntil(AtLeast(6), 3))
+	for _, r := range until.Multiple(1000, D6()) {
+		for _, d := range r.Discarded {
+			if d >= 6 {
+				t.Fatalf("discarded an accepted value: %+v", r)
+			}
+		}
+		if r.First < 6 && len(r.Discarded) != 3 {
+			t.Fatalf("stopped before accepting or running out: %+v", r)
+		}
+	}
+}
+
+func TestRerollWeighted(t *testing.T) {
+	caster := NewIntSource("test-seed").
+		SaltCustomWeighted("weighted", IntWeights{1: 50, 2: 30, 3: 20}).
+		Reroll(RerollOnce(OneOf(1)))
+
+	odds := caster.Odds()
+	want := map[int]float64{1: 25, 2: 30 + 15, 3: 20 + 10}
+	for v, p := range want {
+		if math.Abs(odds.Probabilities[v]-p) > 1e-9 {
+			t.Errorf("P(%d) = %v, want %v", v, odds.Probabilities[v], p)
+		}
+	}
+
+	for _, r := range caster.Multiple(1000) {
+		if len(r.Discarded) > 0 && r.Discarded[0] != 1 {
+			t.Fatalf("rerolled a value the policy does not match: %+v", r)
+		}
+	}
+}
@@ -47,4 +47,47 @@ type Result[T constraint] struct {
 
 	// Rolls contains all individual rolls (for explosive dice)
 	Rolls []T
+
+	// Extras records the policy that produced each roll after the first, in Rolls order
+	// The legacy RerollBelow/RerollAbove chains are recorded as policies "lower" and "upper"
+	Extras []ExtraRoll[T]
+
+	// Discarded holds the values replaced by reroll policies, in the order they were dropped
+	// First is the value that was kept
+	Discarded []T
+}
+
+// ExtraRoll is a roll produced by an explosion
+type ExtraRoll[T constraint] struct {
+	// Policy is the name of the explosion policy
+	Policy string
+
+	// Mode is how the roll counts toward the result
+	Mode ExplosionMode
+
+	// Value is the rolled value
+	Value T
+
+	// Contribution is what the roll adds to Sum (negative for implosions)
+	Contribution T
+
+	// Die is the index in Dice of the die the roll counts toward: the die it extends for compounded
+	// rolls, a new die otherwise
+	Die int
+}
+
+// Dice returns the value of each die, with compounded extra rolls added into the die they extend
+func (r Result[T]) Dice() []T {
+	if len(r.Rolls) == 0 {
+		return nil
+	}
+	dice := []T{r.First}
+	for _, e := range r.Extras {
+		if e.Die < len(dice) {
+			dice[e.Die] += e.Contribution
+			continue
+		}
+		dice = append(dice, e.Contribution)
+	}
+	return dice
 }
@@ -24,6 +24,7 @@ package roll
 
 import (
 	crand "crypto/rand"
+	"maps"
 	"math/rand/v2"
 
 	"lukechampine.com/blake3"
@@ -38,6 +39,7 @@ type IntSource = Source[int]
 // Source holds the seed and default options, acts as a template for casters
 type Source[T constraint] struct {
 	seed       string
+	version    Version
 	opts       Options[T]
 	floatRange func(Range[T]) FloatRange
 	convert    func(float64) T
@@ -48,6 +50,7 @@ type Source[T constraint] struct {
 func NewFloatSource(seed ...string) *FloatSource {
 	return &Source[float64]{
 		seed:       initSeed(seed),
+		version:    V1,
 		opts:       DefaultOptions[float64](),
 		floatRange: floatFloatRange,
 		convert:    floatConvert,
@@ -59,6 +62,7 @@ func NewFloatSource(seed ...string) *FloatSource {
 func NewIntSource(seed ...string) *IntSource {
 	return &Source[int]{
 		seed:       initSeed(seed),
+		version:    V1,
 		opts:       DefaultOptions[int](),
 		floatRange: intFloatRange,
 		convert:    intConvert,
@@ -78,12 +82,15 @@ func initSeed(seed []string) string {
 }
 
 // SaltDist creates a DistCaster with a derived RNG from seed+salt
+// The stream is stable across releases for the same seed, salt, options and call sequence
 func (s *Source[T]) SaltDist(salt string) *DistCaster[T] {
-	var chachaSeed [32]byte
-	blake3.DeriveKey(chachaSeed[:], s.seed, []byte(salt))
+	chachaSeed := s.saltKey(salt)
+	src := rand.NewChaCha8(chachaSeed)
 
 	return &DistCaster[T]{
-		rng:        rand.New(rand.NewChaCha8(chachaSeed)),
+		rng:        rand.New(src),
+		src:        src,
+		key:        newHashKey(chachaSeed),
 		cfg:        distConfigFromOptions(s.opts),
 		floatRange: s.floatRange,
 		convert:    s.convert,
@@ -91,20 +98,23 @@ func (s *Source[T]) SaltDist(salt string) *DistCaster[T] {
 }
 
 // SaltWeighted creates a WeightedCaster with a derived RNG from seed+salt
+// The stream is stable across releases for the same seed, salt, options and call sequence
 func (s *Source[T]) SaltWeighted(salt string) *WeightedCaster[T] {
-	var chachaSeed [32]byte
-	blake3.DeriveKey(chachaSeed[:], s.seed, []byte(salt))
+	chachaSeed := s.saltKey(salt)
+	src := rand.NewChaCha8(chachaSeed)
 
 	return &WeightedCaster[T]{
-		rng: rand.New(rand.NewChaCha8(chachaSeed)),
+		rng: rand.New(src),
+		src: src,
+		key: newHashKey(chachaSeed),
 		cfg: weightedConfigFromOptions(s.opts),
 	}
 }
 
 // SaltCustomWeighted creates a WeightedCaster with provided Weights and a derived RNG from seed+salt
 func (s *Source[T]) SaltCustomWeighted(salt string, weights Weights[T]) *WeightedCaster[T] {
-	var chachaSeed [32]byte
-	blake3.DeriveKey(chachaSeed[:], s.seed, []byte(salt))
+	chachaSeed := s.saltKey(salt)
+	src := rand.NewChaCha8(chachaSeed)
 
 	cfg := weightedConfigFromOptions(s.opts)
 	if len(weights) > 0 {
@@ -112,11 +122,30 @@ func (s *Source[T]) SaltCustomWeighted(salt string, weights Weights[T]) *Weighte
 	}
 
 	return &WeightedCaster[T]{
-		rng: rand.New(rand.NewChaCha8(chachaSeed)),
+		rng: rand.New(src),
+		src: src,
+		key: newHashKey(chachaSeed),
 		cfg: cfg,
 	}
 }
 
+// Roll rolls the value at a given index of the seed+salt stream without rolling the ones before it
+// Equivalent to s.SaltDist(salt).At(index).One(r...), and stable across releases
+func (s *Source[T]) Roll(salt string, index uint64, r ...Range[T]) Result[T] {
+	return s.SaltDist(salt).At(index).One(r...)
+}
+
+// Version sets the key derivation version (default V1)
+// Changing the version changes every roll derived from the source
+// Panics on an unknown version, since silently falling back would break reproducibility
+func (s *Source[T]) Version(v Version) *Source[T] {
+	if v < V1 || v > Latest {
+		panic("source: unknown version")
+	}
+	s.version = v
+	return s
+}
+
 // Dist sets the distribution
 func (s *Source[T]) Dist(d Distribution) *Source[T] {
 	s.opts.Dist = d
@@ -159,6 +188,30 @@ func (s *Source[T]) UpperExplosions(n int) *Source[T] {
 	return s
 }
 
+// Explode sets the explosion policies
+func (s *Source[T]) Explode(policies ...Explosion[T]) *Source[T] {
+	s.opts.Explosions = uniqueNames(policies)
+	return s
+}
+
+// Reroll sets the reroll policies
+func (s *Source[T]) Reroll(policies ...Reroll[T]) *Source[T] {
+	s.opts.Rerolls = policies
+	return s
+}
+
+// Correlation sets the correlation between successive DistCaster rolls
+func (s *Source[T]) Correlation(rho float64) *Source[T] {
+	s.opts.Correlation = rho
+	return s
+}
+
+// Strict sets platform-independent sampling and CDFs (see StrictDistribution)
+func (s *Source[T]) Strict(on bool) *Source[T] {
+	s.opts.Strict = on
+	return s
+}
+
 // With merges the provided options
 func (s *Source[T]) With(opts Options[T]) *Source[T] {
 	s.opts.MergeWith(opts)
@@ -169,3 +222,18 @@ func (s *Source[T]) With(opts Options[T]) *Source[T] {
 func (s *Source[T]) Fork() Source[T] {
 	return *s
 }
+
+// Derive creates a child Source for a namespace path (e.g. "combat", "loot")
+// The child seed is a length-prefixed BLAKE3 derivation of the parent seed, so paths never
+// collide the way concatenated salts can, and Derive("a", "b") equals Derive("a").Derive("b")
+// The child inherits a copy of the options and can be configured independently; with an empty
+// path it keeps this seed, copying only the options
+func (s *Source[T]) Derive(path ...string) *Source[T] {
+	child := *s
+	child.opts.Custom = maps.Clone(s.opts.Custom)
+	if len(path) > 0 {
+		seed := derivePath([]byte(s.seed), path)
+		child.seed = string(seed[:])
+	}
+	return &child
+}
@@ -0,0 +1,310 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rolltest
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/dist"
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// Config holds the parameters of the conformance checks
+// Zero fields other than Weight take the values of DefaultConfig
+type Config struct {
+	// Range is the distribution range [Lower, Upper)
+	Range roll.FloatRange
+
+	// Weight is passed to the distribution as is
+	Weight float64
+
+	// Samples is the number of values drawn with Rand
+	Samples int
+
+	// Bins is the number of equal-width chi-square bins (merged while expected counts are below 5)
+	Bins int
+
+	// Significance is the p-value below which a statistical check fails
+	Significance float64
+
+	// Seed seeds the RNG that draws the samples
+	Seed uint64
+}
+
+// DefaultConfig returns the default conformance config
+func DefaultConfig() Config {
+	return Config{
+		Range:        roll.FloatRange{Lower: 0, Upper: 1},
+		Weight:       0.5,
+		Samples:      20000,
+		Bins:         20,
+		Significance: 0.001,
+		Seed:         1,
+	}
+}
+
+// withDefaults fills zero fields from DefaultConfig
+func (c Config) withDefaults() Config {
+	def := DefaultConfig()
+	if c.Range == (roll.FloatRange{}) {
+		c.Range = def.Range
+	}
+	if c.Samples == 0 {
+		c.Samples = def.Samples
+	}
+	if c.Bins == 0 {
+		c.Bins = def.Bins
+	}
+	if c.Significance == 0 {
+		c.Significance = def.Significance
+	}
+	if c.Seed == 0 {
+		c.Seed = def.Seed
+	}
+	return c
+}
+
+// params returns the distribution params with a fresh RNG
+func (c Config) params() roll.FloatParams {
+	return roll.FloatParams{
+		Range:  c.Range,
+		Weight: c.Weight,
+		Rng:    rand.New(rand.NewPCG(c.Seed, c.Seed^0x9e3779b97f4a7c15)),
+	}
+}
+
+// Report is the outcome of a conformance check
+// Deterministic checks report a p-value of 1 when they pass and 0 when they fail
+type Report struct {
+	// Name is the name of the check
+	Name string
+
+	// Statistic is the test statistic (the number of violations for deterministic checks)
+	Statistic float64
+
+	// PValue is the probability of a statistic at least this extreme for a conforming distribution
+	PValue float64
+
+	// Passed reports whether the p-value is at least the significance
+	Passed bool
+
+	// Detail describes the statistic or the first violation
+	Detail string
+}
+
+// String returns a one-line summary of the report
+func (r Report) String() string {
+	status := "ok"
+	if !r.Passed {
+		status = "FAIL"
+	}
+	return fmt.Sprintf("%s %s: statistic %.4g, p-value %.4g (%s)", status, r.Name, r.Statistic, r.PValue, r.Detail)
+}
+
+// Check runs every conformance check on the distribution
+func Check(d roll.Distribution, cfg Config) []Report {
+	return []Report{
+		CDFBounds(d, cfg),
+		RandMatchesCDF(d, cfg),
+		ChiSquare(d, cfg),
+		KolmogorovSmirnov(d, cfg),
+	}
+}
+
+// Validate runs every conformance check and fails the test for each failing one
+func Validate(t testing.TB, d roll.Distribution, cfg Config) {
+	t.Helper()
+	for _, r := range Check(d, cfg) {
+		if !r.Passed {
+			t.Error(r)
+		}
+	}
+}
+
+// CDFBounds checks that the CDF stays in [0, 1], is non-decreasing, is 0 at Lower and 1 at Upper
+func CDFBounds(d roll.Distribution, cfg Config) Report {
+	cfg = cfg.withDefaults()
+	p := cfg.params()
+	const tolerance = 1e-9
+
+	var violations int
+	var detail string
+	violate := func(format string, args ...any) {
+		if violations == 0 {
+			detail = fmt.Sprintf(format, args...)
+		}
+		violations++
+	}
+
+	if v := d.CDF(p.Lower, p); math.Abs(v) > tolerance {
+		violate("CDF(%v) = %v, want 0", p.Lower, v)
+	}
+	if v := d.CDF(p.Upper, p); math.Abs(v-1) > tolerance {
+		violate("CDF(%v) = %v, want 1", p.Upper, v)
+	}
+
+	points := cfg.Bins * 50
+	prev := 0.0
+	for i := 0; i <= points; i++ {
+		x := p.Lower + (p.Upper-p.Lower)*float64(i)/float64(points)
+		v := d.CDF(x, p)
+		switch {
+		case math.IsNaN(v) || v < 0 || v > 1:
+			violate("CDF(%v) = %v outside [0, 1]", x, v)
+		case v < prev-tolerance:
+			violate("CDF decreases at %v: %v < %v", x, v, prev)
+		}
+		prev = v
+	}
+	if violations == 0 {
+		detail = fmt.Sprintf("%d points", points+3)
+	}
+	return deterministic("cdf bounds", violations, detail)
+}
+
+// RandMatchesCDF checks that Rand stays in the range and that CDF(Rand) is uniform
+// Values equal to Upper are accepted, since casters clamp them below Upper
+// The probability integral transform is binned into Bins equally likely bins for a chi-square test
+func RandMatchesCDF(d roll.Distribution, cfg Config) Report {
+	cfg = cfg.withDefaults()
+	p := cfg.params()
+
+	for i := 0; i < cfg.Samples; i++ {
+		if x := d.Rand(p); math.IsNaN(x) || x < p.Lower || x > p.Upper {
+			return deterministic("rand matches cdf", 1, fmt.Sprintf("Rand returned %v outside [%v, %v]", x, p.Lower, p.Upper))
+		}
+	}
+
+	observed := make([]float64, cfg.Bins)
+	for _, u := range transform(d, cfg) {
+		observed[min(cfg.Bins-1, int(u*float64(cfg.Bins)))]++
+	}
+	expected := float64(cfg.Samples) / float64(cfg.Bins)
+	var stat float64
+	for _, o := range observed {
+		stat += (o - expected) * (o - expected) / expected
+	}
+	pValue := dist.ChiSquared{K: float64(cfg.Bins - 1)}.Survival(stat)
+	return statistical("rand matches cdf", stat, pValue, cfg, fmt.Sprintf("%d probability bins", cfg.Bins))
+}
+
+// ChiSquare runs a chi-square goodness-of-fit test of Rand against the CDF
+func ChiSquare(d roll.Distribution, cfg Config) Report {
+	cfg = cfg.withDefaults()
+	p := cfg.params()
+
+	width := (p.Upper - p.Lower) / float64(cfg.Bins)
+	observed := make([]float64, cfg.Bins)
+	for i := 0; i < cfg.Samples; i++ {
+		bin := int((d.Rand(p) - p.Lower) / width)
+		observed[max(0, min(cfg.Bins-1, bin))]++
+	}
+
+	// Merge adjacent bins until every expected count is at least 5
+	var stat, obs, exp float64
+	var bins int
+	lower := d.CDF(p.Lower, p)
+	for i := 0; i < cfg.Bins; i++ {
+		upper := 1.0
+		if i < cfg.Bins-1 {
+			upper = d.CDF(p.Lower+width*float64(i+1), p)
+		}
+		obs += observed[i]
+		exp += (upper - lower) * float64(cfg.Samples)
+		lower = upper
+		if exp >= 5 || i == cfg.Bins-1 {
+			if exp > 0 {
+				stat += (obs - exp) * (obs - exp) / exp
+				bins++
+			} else if obs > 0 {
+				stat = math.Inf(1)
+			}
+			obs, exp = 0, 0
+		}
+	}
+
+	if bins < 2 {
+		return deterministic("chi-square", 0, "fewer than 2 bins with expected count 5")
+	}
+	pValue := dist.ChiSquared{K: float64(bins - 1)}.Survival(stat)
+	return statistical("chi-square", stat, pValue, cfg, fmt.Sprintf("%d bins", bins))
+}
+
+// KolmogorovSmirnov runs a Kolmogorov-Smirnov test of Rand against the CDF
+// The p-value uses the asymptotic Kolmogorov distribution with Stephens' correction
+func KolmogorovSmirnov(d roll.Distribution, cfg Config) Report {
+	cfg = cfg.withDefaults()
+
+	u := transform(d, cfg)
+	slices.Sort(u)
+
+	n := float64(len(u))
+	var stat float64
+	for i, f := range u {
+		stat = max(stat, float64(i+1)/n-f, f-float64(i)/n)
+	}
+
+	sqrtN := math.Sqrt(n)
+	pValue := dist.Kolmogorov{}.Survival((sqrtN + 0.12 + 0.11/sqrtN) * stat)
+	return statistical("kolmogorov-smirnov", stat, pValue, cfg, fmt.Sprintf("%d samples", len(u)))
+}
+
+// transform draws samples and maps them through the CDF (probability integral transform)
+// Point masses, such as the minimum of WeightedMin, make the CDF jump at a sample: the value
+// is then spread uniformly over the jump, so a conforming distribution always maps to uniform
+func transform(d roll.Distribution, cfg Config) []float64 {
+	p := cfg.params()
+	u := make([]float64, cfg.Samples)
+	for i := range u {
+		x := d.Rand(p)
+		lo := d.CDF(math.Nextafter(x, math.Inf(-1)), p)
+		hi := d.CDF(math.Nextafter(x, math.Inf(1)), p)
+		u[i] = lo + p.Rng.Float64()*(hi-lo)
+	}
+	return u
+}
+
+// statistical builds the report of a statistical check
+func statistical(name string, stat, pValue float64, cfg Config, detail string) Report {
+	return Report{
+		Name:      name,
+		Statistic: stat,
+		PValue:    pValue,
+		Passed:    pValue >= cfg.Significance,
+		Detail:    detail,
+	}
+}
+
+// deterministic builds the report of a deterministic check
+func deterministic(name string, violations int, detail string) Report {
+	r := Report{Name: name, Statistic: float64(violations), Detail: detail}
+	if violations == 0 {
+		r.PValue = 1
+		r.Passed = true
+	}
+	return r
+}
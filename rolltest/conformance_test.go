@@ -0,0 +1,137 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rolltest
+
+import (
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/dist"
+	"github.com/andrei-cosmin/dixe/mathx"
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// mismatched samples uniformly but reports a skewed CDF
+type mismatched struct{}
+
+func (mismatched) Rand(p roll.FloatParams) float64 {
+	return mathx.Scale(p.Rng.Float64(), p.Lower, p.Upper)
+}
+
+func (mismatched) CDF(x float64, p roll.FloatParams) float64 {
+	return roll.Skewed().CDF(x, p)
+}
+
+// decreasing has a CDF that is not monotonic
+type decreasing struct{ mismatched }
+
+func (decreasing) CDF(x float64, p roll.FloatParams) float64 {
+	return dist.Uniform{Min: p.Lower, Max: p.Upper}.CDF(x) * (1.5 - mathx.Normalize(x, p.Lower, p.Upper)) * 2
+}
+
+func TestBuiltinsConform(t *testing.T) {
+	dists := map[string]roll.Distribution{
+		"Uniform":      roll.Uniform(),
+		"Normal":       roll.Normal(),
+		"Skewed":       roll.Skewed(),
+		"WeightedLow":  roll.WeightedLow(),
+		"WeightedMin":  roll.WeightedMin(),
+		"WeightedHigh": roll.WeightedHigh(),
+		"WeightedMax":  roll.WeightedMax(),
+		"Custom": roll.NewBetaDist(func(w float64) (float64, float64) {
+			return 2, 5
+		}),
+	}
+
+	for name, d := range dists {
+		t.Run(name, func(t *testing.T) {
+			for _, w := range []float64{0.2, 0.8} {
+				cfg := DefaultConfig()
+				cfg.Range = roll.FloatRange{Lower: 1, Upper: 101}
+				cfg.Weight = w
+				cfg.Samples = 5000
+				Validate(t, d, cfg)
+			}
+		})
+	}
+}
+
+func TestCombinatorsConform(t *testing.T) {
+	dists := map[string]roll.Distribution{
+		"Mixture":  roll.Mixture([]float64{1, 3}, roll.WeightedMin(), roll.Normal()),
+		"Mirror":   roll.Mirror(roll.WeightedMin()),
+		"MaxOf":    roll.MaxOf(roll.Uniform(), 2),
+		"MinOf":    roll.MinOf(roll.Skewed(), 3),
+		"Truncate": roll.Truncate(roll.Normal(), 0.2, 0.6),
+		"Shift":    roll.Shift(roll.WeightedLow(), 0.25),
+		"Stretch":  roll.Stretch(roll.Normal(), 1.5),
+	}
+
+	for name, d := range dists {
+		t.Run(name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Range = roll.FloatRange{Lower: 1, Upper: 21}
+			cfg.Samples = 5000
+			Validate(t, d, cfg)
+		})
+	}
+}
+
+func TestTablesConform(t *testing.T) {
+	step, err := roll.NewStepDensity([]float64{1, 0, 3, 2, 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	linear, err := roll.NewLinearDensity([]float64{0, 4, 1, 1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cumulative, err := roll.NewCumulative([]float64{0, 0.1, 0.1, 0.6, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, d := range map[string]roll.Distribution{"Step": step, "Linear": linear, "Cumulative": cumulative} {
+		t.Run(name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Range = roll.FloatRange{Lower: 1, Upper: 101}
+			Validate(t, d, cfg)
+		})
+	}
+}
+
+func TestNonConformingDetected(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Samples = 5000
+
+	for _, r := range []Report{ChiSquare(mismatched{}, cfg), KolmogorovSmirnov(mismatched{}, cfg), RandMatchesCDF(mismatched{}, cfg)} {
+		if r.Passed || r.PValue >= cfg.Significance {
+			t.Errorf("mismatched Rand and CDF should fail: %v", r)
+		}
+	}
+	if r := CDFBounds(mismatched{}, cfg); !r.Passed {
+		t.Errorf("a valid CDF should pass the bounds check: %v", r)
+	}
+	if r := CDFBounds(decreasing{}, cfg); r.Passed || r.PValue != 0 {
+		t.Errorf("a decreasing CDF should fail the bounds check: %v", r)
+	}
+}
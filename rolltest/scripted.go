@@ -0,0 +1,169 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rolltest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// number is a type that can be rolled, matching the roll package casters
+type number interface {
+	int | float64
+}
+
+// Scripted is a Caster that returns a queue of predetermined results
+// Odds are reported by a real caster (uniform by default), so code reading odds sees plausible values
+type Scripted[T number] struct {
+	t     testing.TB
+	queue []roll.Result[T]
+	odds  roll.Caster[T]
+}
+
+// Script creates a scripted caster returning the values in order, each as a single roll
+// If t is not nil, the test fails when a value is out of range, the queue runs dry,
+// or values remain unconsumed when the test ends
+func Script[T number](t testing.TB, values ...T) *Scripted[T] {
+	s := &Scripted[T]{t: t, odds: oddsCaster[T](roll.Uniform())}
+	s.Push(values...)
+	if t != nil {
+		t.Helper()
+		t.Cleanup(func() {
+			if len(s.queue) > 0 {
+				t.Errorf("rolltest: %d scripted results not consumed, next is %v", len(s.queue), s.queue[0].First)
+			}
+		})
+	}
+	return s
+}
+
+// Push queues values, each as a single roll without explosions
+func (s *Scripted[T]) Push(values ...T) *Scripted[T] {
+	for _, v := range values {
+		s.queue = append(s.queue, roll.Result[T]{First: v, Last: v, Sum: v, Rolls: []T{v}})
+	}
+	return s
+}
+
+// PushUpper queues a roll followed by its upper explosions
+func (s *Scripted[T]) PushUpper(first T, explosions ...T) *Scripted[T] {
+	r := exploded(first, explosions, "upper")
+	r.UpperExplosions = len(explosions)
+	return s.PushResult(r)
+}
+
+// PushLower queues a roll followed by its lower explosions
+func (s *Scripted[T]) PushLower(first T, explosions ...T) *Scripted[T] {
+	r := exploded(first, explosions, "lower")
+	r.LowerExplosions = len(explosions)
+	return s.PushResult(r)
+}
+
+// PushResult queues complete results, returned as they are
+func (s *Scripted[T]) PushResult(results ...roll.Result[T]) *Scripted[T] {
+	s.queue = append(s.queue, results...)
+	return s
+}
+
+// Dist reports Odds from the distribution with the default weight
+func (s *Scripted[T]) Dist(d roll.Distribution) *Scripted[T] {
+	s.odds = oddsCaster[T](d)
+	return s
+}
+
+// OddsFrom reports Odds from a configured caster
+func (s *Scripted[T]) OddsFrom(c roll.Caster[T]) *Scripted[T] {
+	s.odds = c
+	return s
+}
+
+// Remaining returns the number of queued results
+func (s *Scripted[T]) Remaining() int {
+	return len(s.queue)
+}
+
+// One returns the next queued result
+// The first roll must lie in the range, if given
+func (s *Scripted[T]) One(r ...roll.Range[T]) roll.Result[T] {
+	if len(s.queue) == 0 {
+		s.fail("rolltest: scripted caster has no results left")
+		return roll.Result[T]{}
+	}
+	result := s.queue[0]
+	s.queue = s.queue[1:]
+	if len(r) > 0 && (result.First < r[0].Lower || result.First > r[0].Upper) {
+		s.fail(fmt.Sprintf("rolltest: scripted value %v outside range %v..%v", result.First, r[0].Lower, r[0].Upper))
+	}
+	return result
+}
+
+// Multiple returns the next count queued results
+func (s *Scripted[T]) Multiple(count int, r ...roll.Range[T]) []roll.Result[T] {
+	results := make([]roll.Result[T], count)
+	for i := range results {
+		results[i] = s.One(r...)
+	}
+	return results
+}
+
+// Odds returns the odds of the configured distribution or caster
+func (s *Scripted[T]) Odds(r ...roll.Range[T]) roll.Odds {
+	return s.odds.Odds(r...)
+}
+
+// fail reports a scripting error through the test, or panics without one
+func (s *Scripted[T]) fail(msg string) {
+	if s.t == nil {
+		panic(msg)
+	}
+	s.t.Helper()
+	s.t.Fatal(msg)
+}
+
+// exploded builds a result from a first roll and its explosion rolls, recorded like the legacy chains
+func exploded[T number](first T, explosions []T, policy string) roll.Result[T] {
+	rolls := append([]T{first}, explosions...)
+	var sum T
+	for _, v := range rolls {
+		sum += v
+	}
+	r := roll.Result[T]{First: first, Last: rolls[len(rolls)-1], Sum: sum, Rolls: rolls}
+	for _, v := range explosions {
+		r.Extras = append(r.Extras, roll.ExtraRoll[T]{Policy: policy, Value: v, Contribution: v})
+	}
+	return r
+}
+
+// oddsCaster returns a caster reporting the odds of the distribution
+func oddsCaster[T number](d roll.Distribution) roll.Caster[T] {
+	var c any
+	switch any(*new(T)).(type) {
+	case int:
+		c = roll.NewIntSource("rolltest").Dist(d).SaltDist("odds")
+	default:
+		c = roll.NewFloatSource("rolltest").Dist(d).SaltDist("odds")
+	}
+	return c.(roll.Caster[T])
+}
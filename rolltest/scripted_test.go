@@ -0,0 +1,86 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rolltest
+
+import (
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// attack is game logic under test: a natural 20 crits, a natural 1 fumbles
+func attack(c roll.IntCaster) string {
+	switch c.One(roll.D20()).First {
+	case 20:
+		return "crit"
+	case 1:
+		return "fumble"
+	default:
+		return "hit"
+	}
+}
+
+func TestScriptedSequence(t *testing.T) {
+	dice := Script(t, 20, 1)
+	if got := attack(dice); got != "crit" {
+		t.Errorf("first attack: got %s, want crit", got)
+	}
+	if got := attack(dice); got != "fumble" {
+		t.Errorf("second attack: got %s, want fumble", got)
+	}
+}
+
+func TestScriptedExplosions(t *testing.T) {
+	dice := Script[int](t).PushUpper(6, 6, 3).PushLower(1, 2)
+
+	r := dice.One(roll.D6())
+	if r.Sum != 15 || r.Last != 3 || r.UpperExplosions != 2 || len(r.Rolls) != 3 {
+		t.Errorf("upper explosion metadata: got %+v", r)
+	}
+	r = dice.One(roll.D6())
+	if r.Sum != 3 || r.LowerExplosions != 1 {
+		t.Errorf("lower explosion metadata: got %+v", r)
+	}
+}
+
+func TestScriptedOdds(t *testing.T) {
+	dice := Script[int](t)
+	if p := dice.Odds(roll.D4()).Probabilities[2]; p != 25 {
+		t.Errorf("default odds should be uniform, got %v%%", p)
+	}
+
+	dice.Dist(roll.WeightedHigh())
+	odds := dice.Odds(roll.D6())
+	if odds.Probabilities[6] <= odds.Probabilities[1] {
+		t.Error("odds should follow the configured distribution")
+	}
+}
+
+func TestScriptedOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("a value outside the range should fail")
+		}
+	}()
+	Script[int](nil, 7).One(roll.D6())
+}
@@ -0,0 +1,263 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sim
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sync"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// number is a type that can be rolled, matching the roll package casters
+type number interface {
+	int | float64
+}
+
+// Trial runs a single simulated trial and returns its outcome
+type Trial[T number] func(c *roll.DistCaster[T]) float64
+
+// Runner splits a simulation into fixed-size chunks run by parallel workers
+// Chunk i rolls on the indexed substream SaltDist(salt).At(i) of the source, and chunk results
+// are merged in chunk order, so the summary only depends on the source, salt, trials and chunk
+// size: never on the number of workers or GOMAXPROCS
+type Runner[T number] struct {
+	src       *roll.Source[T]
+	salt      string
+	trials    int
+	chunkSize int
+	workers   int
+	progress  func(done, total int)
+}
+
+// New creates a runner for the source and salt
+func New[T number](src *roll.Source[T], salt string) *Runner[T] {
+	return &Runner[T]{
+		src:       src,
+		salt:      salt,
+		trials:    1_000_000,
+		chunkSize: 10_000,
+		workers:   runtime.GOMAXPROCS(0),
+	}
+}
+
+// Trials sets the number of trials
+func (r *Runner[T]) Trials(n int) *Runner[T] {
+	r.trials = n
+	return r
+}
+
+// ChunkSize sets the number of trials per chunk
+// Changing it changes the substreams, and so the results
+func (r *Runner[T]) ChunkSize(n int) *Runner[T] {
+	r.chunkSize = max(1, n)
+	return r
+}
+
+// Workers sets the number of goroutines, GOMAXPROCS by default
+func (r *Runner[T]) Workers(n int) *Runner[T] {
+	r.workers = max(1, n)
+	return r
+}
+
+// Progress sets a callback receiving the completed and total trials after each chunk
+// The callback is called from a single goroutine
+func (r *Runner[T]) Progress(fn func(done, total int)) *Runner[T] {
+	r.progress = fn
+	return r
+}
+
+// Run runs the trials and returns the summary of their outcomes
+// When ctx is cancelled, Run returns the summary of the chunks completed in order so far
+// together with the context error
+func (r *Runner[T]) Run(ctx context.Context, trial Trial[T]) (Summary, error) {
+	chunks := (r.trials + r.chunkSize - 1) / r.chunkSize
+	caster := r.src.SaltDist(r.salt)
+
+	jobs := make(chan int)
+	done := make(chan int)
+	results := make([]*Summary, chunks)
+
+	var wg sync.WaitGroup
+	for range min(r.workers, max(1, chunks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range jobs {
+				n := min(r.chunkSize, r.trials-chunk*r.chunkSize)
+				results[chunk] = runChunk(ctx, caster.At(uint64(chunk)), trial, n)
+				done <- results[chunk].Trials
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for chunk := 0; chunk < chunks; chunk++ {
+			select {
+			case jobs <- chunk:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	var completed int
+	for n := range done {
+		completed += n
+		if r.progress != nil {
+			r.progress(completed, r.trials)
+		}
+	}
+
+	summary := newSummary()
+	for chunk := 0; chunk < chunks; chunk++ {
+		if results[chunk] == nil || results[chunk].Trials < min(r.chunkSize, r.trials-chunk*r.chunkSize) {
+			break
+		}
+		summary.merge(results[chunk])
+	}
+	return *summary, ctx.Err()
+}
+
+// runChunk runs the trials of a chunk, stopping early if the context is cancelled
+func runChunk[T number](ctx context.Context, c *roll.DistCaster[T], trial Trial[T], n int) *Summary {
+	s := newSummary()
+	for i := 0; i < n; i++ {
+		if i%1024 == 0 && ctx.Err() != nil {
+			break
+		}
+		s.add(trial(c))
+	}
+	return s
+}
+
+// Summary holds statistics and a histogram of trial outcomes
+type Summary struct {
+	// Trials is the number of trials summarized
+	Trials int
+
+	// Mean is the mean outcome
+	Mean float64
+
+	// Min is the smallest outcome
+	Min float64
+
+	// Max is the largest outcome
+	Max float64
+
+	// Histogram counts outcomes by integer bucket: bucket X counts X <= outcome < X+1
+	Histogram map[int]int
+
+	// m2 is the sum of squared deviations from the mean
+	m2 float64
+}
+
+// newSummary creates an empty summary
+func newSummary() *Summary {
+	return &Summary{
+		Min:       math.Inf(1),
+		Max:       math.Inf(-1),
+		Histogram: make(map[int]int),
+	}
+}
+
+// Variance returns the sample variance of the outcomes
+func (s Summary) Variance() float64 {
+	if s.Trials < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.Trials-1)
+}
+
+// StdDev returns the sample standard deviation of the outcomes
+func (s Summary) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// Percentile returns the smallest bucket at or below which at least q (0-100) percent of outcomes fall
+func (s Summary) Percentile(q float64) int {
+	if s.Trials == 0 {
+		return 0
+	}
+	lo, hi := int(math.Floor(s.Min)), int(math.Floor(s.Max))
+	target := q / 100 * float64(s.Trials)
+	var cumulative int
+	for bucket := lo; bucket < hi; bucket++ {
+		cumulative += s.Histogram[bucket]
+		if float64(cumulative) >= target {
+			return bucket
+		}
+	}
+	return hi
+}
+
+// Chance returns the percentage (0-100) of outcomes at or above x
+// x is an integer since outcomes are only kept in floor buckets, which is exact at bucket edges
+func (s Summary) Chance(x int) float64 {
+	if s.Trials == 0 {
+		return 0
+	}
+	var count int
+	for bucket, n := range s.Histogram {
+		if bucket >= x {
+			count += n
+		}
+	}
+	return float64(count) / float64(s.Trials) * 100
+}
+
+// add records a single outcome (Welford's update)
+func (s *Summary) add(x float64) {
+	s.Trials++
+	delta := x - s.Mean
+	s.Mean += delta / float64(s.Trials)
+	s.m2 += delta * (x - s.Mean)
+	s.Min = min(s.Min, x)
+	s.Max = max(s.Max, x)
+	s.Histogram[int(math.Floor(x))]++
+}
+
+// merge combines another summary into this one (Chan's parallel update)
+func (s *Summary) merge(o *Summary) {
+	if o.Trials == 0 {
+		return
+	}
+	n := s.Trials + o.Trials
+	delta := o.Mean - s.Mean
+	s.Mean += delta * float64(o.Trials) / float64(n)
+	s.m2 += o.m2 + delta*delta*float64(s.Trials)*float64(o.Trials)/float64(n)
+	s.Trials = n
+	s.Min = min(s.Min, o.Min)
+	s.Max = max(s.Max, o.Max)
+	for bucket, count := range o.Histogram {
+		s.Histogram[bucket] += count
+	}
+}
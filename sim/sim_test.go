@@ -0,0 +1,122 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sim
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// attack rolls a d20 to hit and 2d6 of damage on a hit
+func attack(c *roll.IntDistCaster) float64 {
+	if c.One(roll.D20()).First < 11 {
+		return 0
+	}
+	var damage int
+	for _, r := range c.Multiple(2, roll.D6()) {
+		damage += r.First
+	}
+	return float64(damage)
+}
+
+func TestRunIndependentOfWorkers(t *testing.T) {
+	src := roll.NewIntSource("test-seed")
+	var want Summary
+	for i, workers := range []int{1, 3, 8} {
+		got, err := New(src, "sim").Trials(50_000).ChunkSize(1_000).Workers(workers).Run(context.Background(), attack)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			want = got
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%d workers: summary differs from 1 worker", workers)
+		}
+	}
+	if want.Trials != 50_000 || want.Min != 0 || want.Histogram[0] == 0 {
+		t.Errorf("unexpected summary: %+v", want)
+	}
+}
+
+func TestRunStatistics(t *testing.T) {
+	d20 := func(c *roll.IntDistCaster) float64 { return float64(c.One(roll.D20()).First) }
+	s, err := New(roll.NewIntSource("test-seed"), "d20").Trials(100_000).Run(context.Background(), d20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(s.Mean-10.5) > 0.1 {
+		t.Errorf("mean: got %.3f, want 10.5", s.Mean)
+	}
+	if math.Abs(s.Variance()-33.25) > 1 {
+		t.Errorf("variance: got %.3f, want 33.25", s.Variance())
+	}
+	if s.Min != 1 || s.Max != 20 || s.Percentile(50) != 10 {
+		t.Errorf("min %v, max %v, median %d", s.Min, s.Max, s.Percentile(50))
+	}
+	if c := s.Chance(20); math.Abs(c-5) > 0.5 {
+		t.Errorf("chance of 20: got %.2f%%, want 5%%", c)
+	}
+}
+
+func TestChanceFractionalOutcomes(t *testing.T) {
+	s := newSummary()
+	for _, x := range []float64{1.5, 2.2, 2.7, 3.9, 4} {
+		s.add(x)
+	}
+	for x, want := range map[int]float64{2: 80, 3: 40, 4: 20, 5: 0} {
+		if got := s.Chance(x); got != want {
+			t.Errorf("chance of %d or more: got %v%%, want %v%%", x, got, want)
+		}
+	}
+}
+
+func TestRunCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls, last int
+	progress := func(done, total int) {
+		calls++
+		if done < last || total != 1_000_000 {
+			t.Errorf("progress should increase towards the total: %d/%d", done, total)
+		}
+		last = done
+		if done >= 20_000 {
+			cancel()
+		}
+	}
+
+	trial := func(c *roll.IntDistCaster) float64 { return float64(c.One(roll.D6()).First) }
+	s, err := New(roll.NewIntSource("test-seed"), "cancel").Workers(2).Progress(progress).Run(ctx, trial)
+	if err != context.Canceled {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+	if s.Trials == 0 || s.Trials >= 1_000_000 || s.Trials%10_000 != 0 || calls == 0 {
+		t.Errorf("cancelled run should summarize whole completed chunks, got %d trials", s.Trials)
+	}
+}
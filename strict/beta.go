@@ -0,0 +1,98 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package strict
+
+import "math"
+
+// RegIncBeta returns the regularized incomplete beta function I(x; a, b) for a, b > 0
+// Evaluated by continued fraction (modified Lentz), bit-identical on every platform
+func RegIncBeta(a, b, x float64) float64 {
+	switch {
+	case math.IsNaN(x) || a <= 0 || b <= 0:
+		return math.NaN()
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+
+	// x^a (1-x)^b / B(a, b), computed in log space
+	logFront := Lgamma(a+b) - Lgamma(a) - Lgamma(b) + float64(a*Log(x)) + float64(b*Log(1-x))
+	front := Exp(logFront)
+
+	// The continued fraction converges quickly below the mean; use symmetry above it
+	if x < (a+1)/(a+b+2) {
+		return float64(front*betaContinuedFraction(a, b, x)) / a
+	}
+	return 1 - float64(front*betaContinuedFraction(b, a, 1-x))/b
+}
+
+// betaContinuedFraction evaluates the continued fraction for I(x; a, b)
+func betaContinuedFraction(a, b, x float64) float64 {
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+	c := 1.0
+	d := 1 - float64(qab*x)/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIter; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+
+		// Even step
+		aa := float64(fm*(b-fm)) * x / float64((qam+m2)*(a+m2))
+		d = 1 + float64(aa*d)
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= float64(d * c)
+
+		// Odd step
+		aa = -float64((a+fm)*(qab+fm)) * x / float64((a+m2)*(qap+m2))
+		d = 1 + float64(aa*d)
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := float64(d * c)
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
@@ -0,0 +1,135 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the THIRD_PARTY_LICENSES file.
+//
+// Derived from the pure Go implementations in the Go math package,
+// which are in turn derived from FreeBSD's /usr/src/lib/msun/src/e_exp.c
+// and e_log.c (Copyright (C) 1993 by Sun Microsystems, Inc.)
+//
+// Every product that feeds an addition is wrapped in an explicit float64
+// conversion, which the Go specification guarantees is rounded on its own
+// and never fused into a multiply-add.
+
+package strict
+
+import "math"
+
+// Exp returns e**x, bit-identical on every platform
+func Exp(x float64) float64 {
+	const (
+		Ln2Hi = 6.93147180369123816490e-01
+		Ln2Lo = 1.90821492927058770002e-10
+		Log2e = 1.44269504088896338700e+00
+
+		Overflow  = 7.09782712893383973096e+02
+		Underflow = -7.45133219101941108420e+02
+		NearZero  = 1.0 / (1 << 28)
+	)
+
+	switch {
+	case math.IsNaN(x):
+		return x
+	case x > Overflow:
+		return math.Inf(1)
+	case x < Underflow:
+		return 0
+	case -NearZero < x && x < NearZero:
+		return 1 + x
+	}
+
+	// Reduce; computed as r = hi - lo for extra precision
+	var k int
+	switch {
+	case x < 0:
+		k = int(float64(Log2e*x) - 0.5)
+	case x > 0:
+		k = int(float64(Log2e*x) + 0.5)
+	}
+	hi := x - float64(float64(k)*Ln2Hi)
+	lo := float64(float64(k) * Ln2Lo)
+
+	return expmulti(hi, lo, k)
+}
+
+// expmulti returns e**r × 2**k where r = hi - lo and |r| ≤ ln(2)/2
+func expmulti(hi, lo float64, k int) float64 {
+	const (
+		P1 = 1.66666666666666657415e-01
+		P2 = -2.77777777770155933842e-03
+		P3 = 6.61375632143793436117e-05
+		P4 = -1.65339022054652515390e-06
+		P5 = 4.13813679705723846039e-08
+	)
+
+	r := hi - lo
+	t := r * r
+	poly := P4 + float64(t*P5)
+	poly = P3 + float64(t*poly)
+	poly = P2 + float64(t*poly)
+	poly = P1 + float64(t*poly)
+	c := r - float64(t*poly)
+	y := 1 - ((lo - float64(r*c)/(2-c)) - hi)
+	return math.Ldexp(y, k)
+}
+
+// Log returns the natural logarithm of x, bit-identical on every platform
+func Log(x float64) float64 {
+	const (
+		Ln2Hi = 6.93147180369123816490e-01
+		Ln2Lo = 1.90821492927058770002e-10
+		L1    = 6.666666666666735130e-01
+		L2    = 3.999999999940941908e-01
+		L3    = 2.857142874366239149e-01
+		L4    = 2.222219843214978396e-01
+		L5    = 1.818357216161805012e-01
+		L6    = 1.531383769920937332e-01
+		L7    = 1.479819860511658591e-01
+	)
+
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 1):
+		return x
+	case x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	}
+
+	// Reduce
+	f1, ki := math.Frexp(x)
+	if f1 < math.Sqrt2/2 {
+		f1 *= 2
+		ki--
+	}
+	f := f1 - 1
+	k := float64(ki)
+
+	s := f / (2 + f)
+	s2 := s * s
+	s4 := s2 * s2
+	odd := L5 + float64(s4*L7)
+	odd = L3 + float64(s4*odd)
+	odd = L1 + float64(s4*odd)
+	even := L4 + float64(s4*L6)
+	even = L2 + float64(s4*even)
+	R := float64(s2*odd) + float64(s4*even)
+	hfsq := float64(0.5 * f * f)
+	return float64(k*Ln2Hi) - ((hfsq - (float64(s*(hfsq+R)) + float64(k*Ln2Lo))) - f)
+}
+
+// Pow returns x**y for x > 0 as Exp(y * Log(x)), bit-identical on every platform
+// Less accurate than math.Pow for large results, which is irrelevant for probabilities
+func Pow(x, y float64) float64 {
+	switch {
+	case y == 0 || x == 1:
+		return 1
+	case x == 0:
+		if y > 0 {
+			return 0
+		}
+		return math.Inf(1)
+	case x < 0:
+		return math.NaN()
+	}
+	return Exp(float64(y * Log(x)))
+}
@@ -0,0 +1,196 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package strict
+
+import "math"
+
+const (
+	// epsilon is the relative tolerance for series and continued fractions
+	epsilon = 1.0 / (1 << 52)
+
+	// tiny guards continued fraction denominators against zero (modified Lentz)
+	tiny = 1e-300
+
+	// maxIter bounds series and continued fraction iterations
+	maxIter = 1000
+)
+
+// lanczos holds the Lanczos coefficients for g = 7, n = 9
+var lanczos = [...]float64{
+	0.99999999999980993,
+	676.5203681218851,
+	-1259.1392167224028,
+	771.32342877765313,
+	-176.61502916214059,
+	12.507343278686905,
+	-0.13857109526572012,
+	9.9843695780195716e-6,
+	1.5056327351493116e-7,
+}
+
+// Lgamma returns log(Γ(x)) for x > 0 using the Lanczos approximation
+// Accurate to about 1e-15 relative, bit-identical on every platform
+func Lgamma(x float64) float64 {
+	const halfLog2Pi = 0.91893853320467274178032973640562
+
+	switch {
+	case math.IsNaN(x) || x <= 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return x
+	case x < 0.5:
+		// Γ(x) = Γ(x+1) / x keeps the approximation in its accurate region
+		return Lgamma(x+1) - Log(x)
+	}
+
+	x -= 1
+	a := lanczos[0]
+	for i := 1; i < len(lanczos); i++ {
+		a += lanczos[i] / (x + float64(i))
+	}
+	t := x + 7.5
+	return halfLog2Pi + float64((x+0.5)*Log(t)) - t + Log(a)
+}
+
+// RegIncGamma returns the regularized lower incomplete gamma function P(a, x)
+func RegIncGamma(a, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case math.IsInf(x, 1):
+		return 1
+	case x < a+1:
+		return gammaSeries(a, x)
+	}
+	return 1 - gammaContinuedFraction(a, x)
+}
+
+// RegIncGammaComp returns the regularized upper incomplete gamma function Q(a, x) = 1 - P(a, x)
+func RegIncGammaComp(a, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 1
+	case math.IsInf(x, 1):
+		return 0
+	case x < a+1:
+		return 1 - gammaSeries(a, x)
+	}
+	return gammaContinuedFraction(a, x)
+}
+
+// gammaPrefix returns x^a e^-x / Γ(a), computed in log space
+func gammaPrefix(a, x float64) float64 {
+	return Exp(float64(a*Log(x)) - x - Lgamma(a))
+}
+
+// gammaSeries evaluates P(a, x) by its power series, for x < a + 1
+func gammaSeries(a, x float64) float64 {
+	ap := a
+	term := 1 / a
+	sum := term
+	for range maxIter {
+		ap++
+		term = float64(term * (x / ap))
+		sum += term
+		if math.Abs(term) < math.Abs(sum)*epsilon {
+			break
+		}
+	}
+	return float64(sum * gammaPrefix(a, x))
+}
+
+// gammaContinuedFraction evaluates Q(a, x) by its continued fraction (modified Lentz), for x >= a + 1
+func gammaContinuedFraction(a, x float64) float64 {
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i <= maxIter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = float64(an*d) + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := float64(d * c)
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return float64(gammaPrefix(a, x) * h)
+}
+
+// NormCDF returns the standard normal cumulative distribution function Φ(z)
+// Uses erfc(|z|/√2) = Q(1/2, z²/2), which keeps full relative precision in both tails
+func NormCDF(z float64) float64 {
+	switch {
+	case math.IsNaN(z):
+		return z
+	case z == 0:
+		return 0.5
+	}
+	tail := float64(0.5 * RegIncGammaComp(0.5, float64(z*z)/2))
+	if z < 0 {
+		return tail
+	}
+	return 1 - tail
+}
+
+// NormQuantile returns the inverse of NormCDF by bisection, bit-identical on every platform
+func NormQuantile(p float64) float64 {
+	switch {
+	case math.IsNaN(p):
+		return p
+	case p <= 0:
+		return math.Inf(-1)
+	case p >= 1:
+		return math.Inf(1)
+	}
+	return Bisect(func(z float64) float64 { return NormCDF(z) }, p, -40, 40)
+}
+
+// bisectIterations bounds Bisect (enough to exhaust float64 precision on any finite interval)
+const bisectIterations = 2100
+
+// Bisect returns the largest x in [lo, hi) found with cdf(x) <= u, for a non-decreasing cdf
+// Uses only comparisons and exact halving, so it is deterministic whenever cdf is
+func Bisect(cdf func(float64) float64, u, lo, hi float64) float64 {
+	for range bisectIterations {
+		mid := lo + float64((hi-lo)/2)
+		if mid <= lo || mid >= hi {
+			break
+		}
+		if cdf(mid) <= u {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
@@ -0,0 +1,86 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package strict
+
+import (
+	"math"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/dist"
+	"github.com/stretchr/testify/assert"
+)
+
+// conformance pins exact outputs; every platform must reproduce them bit for bit
+// Run on each target, e.g. GOARCH=386 go test ./strict or GOAMD64=v3 go test ./strict
+var conformance = []struct {
+	name string
+	got  func() float64
+	want uint64
+}{
+	{"Exp(-3.7)", func() float64 { return Exp(-3.7) }, 0x3f99511fc6871044},
+	{"Exp(12.25)", func() float64 { return Exp(12.25) }, 0x410982aa4f9aec90},
+	{"Log(0.013)", func() float64 { return Log(0.013) }, 0xc0115f0883f73978},
+	{"Log(7919)", func() float64 { return Log(7919) }, 0x4021f43bfe572118},
+	{"Pow(0.3, 2.7)", func() float64 { return Pow(0.3, 2.7) }, 0x3fa3d6858f50590e},
+	{"Lgamma(0.1)", func() float64 { return Lgamma(0.1) }, 0x4002058e35f3deef},
+	{"Lgamma(4.5)", func() float64 { return Lgamma(4.5) }, 0x4003a140a3a623cb},
+	{"RegIncGamma(0.5, 0.8)", func() float64 { return RegIncGamma(0.5, 0.8) }, 0x3fe9693dab7875b8},
+	{"NormCDF(-2.5)", func() float64 { return NormCDF(-2.5) }, 0x3f796f4e57e49ce1},
+	{"NormCDF(1.1)", func() float64 { return NormCDF(1.1) }, 0x3feba89fa621dc4e},
+	{"NormQuantile(0.975)", func() float64 { return NormQuantile(0.975) }, 0x3fff5c0331eeff8b},
+	{"RegIncBeta(0.35, 0.35, 0.2)", func() float64 { return RegIncBeta(0.35, 0.35, 0.2) }, 0x3fd59fa4d3961789},
+	{"RegIncBeta(2.2, 1, 0.9)", func() float64 { return RegIncBeta(2.2, 1, 0.9) }, 0x3fe96128962b8a17},
+}
+
+func TestConformance(t *testing.T) {
+	for _, c := range conformance {
+		t.Run(c.name, func(t *testing.T) {
+			if got := math.Float64bits(c.got()); got != c.want {
+				t.Errorf("got %#016x, want %#016x", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAccuracy(t *testing.T) {
+	for x := -700.0; x < 700; x += 0.37 {
+		assert.InEpsilon(t, math.Exp(x), Exp(x), 1e-15, "Exp(%v)", x)
+	}
+	for x := 1e-6; x < 1e4; x *= 1.07 {
+		assert.InEpsilon(t, math.Log(x), Log(x), 1e-15, "Log(%v)", x)
+		want, _ := math.Lgamma(x)
+		assert.InDelta(t, want, Lgamma(x), 1e-12*max(1, math.Abs(want)), "Lgamma(%v)", x)
+	}
+	for z := -30.0; z < 8; z += 0.01 {
+		assert.InEpsilon(t, 0.5*math.Erfc(-z/math.Sqrt2), NormCDF(z), 1e-12, "NormCDF(%v)", z)
+	}
+	for _, p := range []float64{1e-12, 0.001, 0.3, 0.5, 0.77, 0.999999} {
+		assert.InEpsilon(t, p, NormCDF(NormQuantile(p)), 1e-14, "NormQuantile(%v)", p)
+	}
+	for _, ab := range [][2]float64{{0.1, 0.1}, {0.35, 0.35}, {1, 1}, {1, 5}, {5, 1}, {2, 1}, {0.5, 7}} {
+		for x := 0.001; x < 1; x += 0.001 {
+			want := dist.Beta{Alpha: ab[0], Beta: ab[1]}.CDF(x)
+			assert.InDelta(t, want, RegIncBeta(ab[0], ab[1], x), 1e-13, "RegIncBeta(%v, %v, %v)", ab[0], ab[1], x)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package table
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Issue is a problem found by Lint
+type Issue struct {
+	// Table is the name of the table with the problem
+	Table string
+
+	// Line is the line of the offending row, or of the table header
+	Line int
+
+	// Message describes the problem
+	Message string
+}
+
+// String returns the issue as "table:line: message"
+func (i Issue) String() string {
+	return fmt.Sprintf("%s:%d: %s", i.Table, i.Line, i.Message)
+}
+
+// Lint checks every table for totals no row covers, rows that overlap or fall outside the die,
+// references to unknown tables and reference cycles
+func (s *Set) Lint() []Issue {
+	var issues []Issue
+	for _, t := range s.Tables() {
+		issues = append(issues, t.lintRanges()...)
+		for _, row := range t.Rows {
+			for _, ref := range row.Refs() {
+				if _, ok := s.tables[ref]; !ok {
+					issues = append(issues, Issue{Table: t.Name, Line: row.Line, Message: fmt.Sprintf("unknown table %q", ref)})
+				}
+			}
+		}
+	}
+	return append(issues, s.lintCycles()...)
+}
+
+// lintRanges reports gaps, overlaps and rows outside the die totals
+func (t *Table) lintRanges() []Issue {
+	var issues []Issue
+	keys := t.Die.Keys()
+	inRow := func(row Row) []int {
+		var in []int
+		for _, k := range keys {
+			if k >= row.Lower && k <= row.Upper {
+				in = append(in, k)
+			}
+		}
+		return in
+	}
+
+	covered := make(map[int]bool, len(keys))
+	for i, row := range t.Rows {
+		in := inRow(row)
+		if len(in) == 0 {
+			issues = append(issues, Issue{Table: t.Name, Line: row.Line,
+				Message: fmt.Sprintf("row %s is outside the totals of %s", span(row.Lower, row.Upper), t.Die)})
+		}
+		for _, prev := range t.Rows[:i] {
+			lo, hi := max(prev.Lower, row.Lower), min(prev.Upper, row.Upper)
+			if lo <= hi && slices.ContainsFunc(in, func(k int) bool { return k >= lo && k <= hi }) {
+				issues = append(issues, Issue{Table: t.Name, Line: row.Line,
+					Message: fmt.Sprintf("row %s overlaps line %d on %s", span(row.Lower, row.Upper), prev.Line, span(lo, hi))})
+			}
+		}
+		for _, k := range in {
+			covered[k] = true
+		}
+	}
+
+	// Report each run of uncovered totals once
+	for i := 0; i < len(keys); i++ {
+		if covered[keys[i]] {
+			continue
+		}
+		j := i
+		for j+1 < len(keys) && !covered[keys[j+1]] {
+			j++
+		}
+		issues = append(issues, Issue{Table: t.Name, Line: t.Line,
+			Message: fmt.Sprintf("no row covers %s", span(keys[i], keys[j]))})
+		i = j
+	}
+	return issues
+}
+
+// lintCycles reports table references that lead back to the same table
+func (s *Set) lintCycles() []Issue {
+	var issues []Issue
+	done := make(map[string]bool)
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		if i := slices.Index(path, name); i >= 0 {
+			cycle := append(slices.Clone(path[i:]), name)
+			issues = append(issues, Issue{Table: name, Line: s.tables[name].Line,
+				Message: "reference cycle " + strings.Join(cycle, " -> ")})
+			return
+		}
+		t, ok := s.tables[name]
+		if !ok || done[name] {
+			return
+		}
+		path = append(path, name)
+		for _, row := range t.Rows {
+			for _, ref := range row.Refs() {
+				visit(ref)
+			}
+		}
+		path = path[:len(path)-1]
+		done[name] = true
+	}
+	for _, name := range s.order {
+		visit(name)
+	}
+	return issues
+}
+
+// span formats an inclusive range of totals
+func span(lower, upper int) string {
+	if lower == upper {
+		return fmt.Sprint(lower)
+	}
+	return fmt.Sprintf("%d-%d", lower, upper)
+}
@@ -0,0 +1,70 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package table
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	if issues := mustParse(t, encounters).Lint(); len(issues) != 0 {
+		t.Errorf("clean tables reported %v", issues)
+	}
+
+	doc := `# Broken
+
+| d20   | Result          |
+|-------|-----------------|
+| 1-5   | A               |
+| 5-8   | {table:Missing} |
+| 11-20 | {table:Loop}    |
+| 21-25 | Off the die     |
+
+# Loop
+
+| d6  | Result          |
+|-----|-----------------|
+| 1-6 | {table:Broken}  |
+`
+	var messages []string
+	for _, issue := range mustParse(t, doc).Lint() {
+		messages = append(messages, issue.String())
+	}
+	want := []string{
+		"Broken:6: row 5-8 overlaps line 5 on 5",
+		"Broken:8: row 21-25 is outside the totals of d20",
+		"Broken:3: no row covers 9-10",
+		`Broken:6: unknown table "Missing"`,
+		"Broken:3: reference cycle Broken -> Loop -> Broken",
+	}
+	for _, w := range want {
+		if !slices.Contains(messages, w) {
+			t.Errorf("missing issue %q in:\n%s", w, strings.Join(messages, "\n"))
+		}
+	}
+	if len(messages) != len(want) {
+		t.Errorf("got %d issues, want %d:\n%s", len(messages), len(want), strings.Join(messages, "\n"))
+	}
+}
@@ -0,0 +1,144 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package table
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// maxDepth bounds nested table references while rolling
+const maxDepth = 16
+
+// Roller rolls the tables of a set deterministically for a source and salt
+// Each table rolls on its own stream, Derive("table", name) of the source with the salt, and
+// quantities on Derive("table", name, "quantity"), so adding or rolling one table never shifts
+// the rolls of another
+type Roller struct {
+	set     *Set
+	src     *roll.IntSource
+	salt    string
+	casters map[string]*roll.IntDistCaster
+}
+
+// Result is the outcome of a table roll
+type Result struct {
+	// Table is the name of the table rolled
+	Table string
+
+	// Total is the table die total
+	Total int
+
+	// Row is the row selected by the total
+	Row Row
+
+	// Rolls holds each caster roll behind the table die total, with its rerolls and explosions
+	Rolls []roll.IntResult
+
+	// Quantity is the rolled quantity, 0 if the row has none
+	Quantity int
+
+	// QuantityRolls holds each caster roll behind a rolled quantity
+	QuantityRolls []roll.IntResult
+
+	// Text is the row text with each table reference replaced by its nested result
+	Text string
+
+	// Nested holds the results of the referenced tables, in order
+	Nested []Result
+}
+
+// NewRoller creates a roller for the set, rolling with the source's options and the salt
+func NewRoller(set *Set, src *roll.IntSource, salt string) *Roller {
+	return &Roller{set: set, src: src, salt: salt, casters: make(map[string]*roll.IntDistCaster)}
+}
+
+// Roll rolls the named table, following its references
+func (r *Roller) Roll(name string) (Result, error) {
+	return r.roll(name, 0)
+}
+
+// String returns the result text, prefixed by the quantity when the row has one
+func (res Result) String() string {
+	if res.Row.Quantity == "" {
+		return res.Text
+	}
+	return strconv.Itoa(res.Quantity) + " " + res.Text
+}
+
+// roll rolls a table at a nesting depth
+func (r *Roller) roll(name string, depth int) (Result, error) {
+	if depth > maxDepth {
+		return Result{}, fmt.Errorf("table: references nested deeper than %d", maxDepth)
+	}
+	t, ok := r.set.tables[name]
+	if !ok {
+		return Result{}, fmt.Errorf("table: unknown table %q", name)
+	}
+
+	die := roll.Record[int](r.caster(name))
+	total := t.Die.Roll(die).Sum
+	row, ok := t.Lookup(total)
+	if !ok {
+		return Result{}, fmt.Errorf("table: %s: no row for %d", name, total)
+	}
+	res := Result{Table: name, Total: total, Row: row, Rolls: die.Journal().Results()}
+
+	switch {
+	case row.dice != nil:
+		quantity := roll.Record[int](r.caster(name, "quantity"))
+		res.Quantity = row.dice.Roll(quantity).Sum
+		res.QuantityRolls = quantity.Journal().Results()
+	case row.Quantity != "":
+		res.Quantity = row.fixed
+	}
+
+	var err error
+	res.Text = refPattern.ReplaceAllStringFunc(row.Text, func(ref string) string {
+		if err != nil {
+			return ref
+		}
+		var nested Result
+		nested, err = r.roll(strings.TrimSpace(refPattern.FindStringSubmatch(ref)[1]), depth+1)
+		res.Nested = append(res.Nested, nested)
+		return nested.String()
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	return res, nil
+}
+
+// caster returns the stream for a table path, creating it on first use
+func (r *Roller) caster(path ...string) *roll.IntDistCaster {
+	key := strings.Join(path, "\x00")
+	c, ok := r.casters[key]
+	if !ok {
+		c = r.src.Derive(append([]string{"table"}, path...)...).SaltDist(r.salt)
+		r.casters[key] = c
+	}
+	return c
+}
@@ -0,0 +1,128 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+func TestRollDeterministic(t *testing.T) {
+	set := mustParse(t, encounters)
+	roll1 := NewRoller(set, roll.NewIntSource("test-seed"), "session-1")
+	roll2 := NewRoller(set, roll.NewIntSource("test-seed"), "session-1")
+	other := NewRoller(set, roll.NewIntSource("test-seed"), "session-2")
+
+	var differs bool
+	for i := 0; i < 50; i++ {
+		a, err := roll1.Roll("Encounters")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := roll2.Roll("Encounters")
+		c, _ := other.Roll("Encounters")
+		if a.String() != b.String() || a.Total != b.Total {
+			t.Fatalf("roll %d: %q vs %q for the same seed and salt", i, a, b)
+		}
+		differs = differs || a.Total != c.Total
+	}
+	if !differs {
+		t.Error("different salts should roll differently")
+	}
+}
+
+func TestRollNested(t *testing.T) {
+	set := mustParse(t, encounters)
+	r := NewRoller(set, roll.NewIntSource("test-seed"), "nested")
+
+	var goblins, nested int
+	for i := 0; i < 500; i++ {
+		res, err := r.Roll("Encounters")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Rolls) == 0 {
+			t.Fatalf("no die rolls recorded for total %d", res.Total)
+		}
+		if row, _ := set.tables["Encounters"].Lookup(res.Total); row.Line != res.Row.Line {
+			t.Fatalf("total %d selected line %d", res.Total, res.Row.Line)
+		}
+		switch {
+		case res.Text == "Goblins":
+			goblins++
+			if res.Quantity < 2 || res.Quantity > 8 || !strings.HasSuffix(res.String(), " Goblins") {
+				t.Fatalf("2d4 goblins rolled %q", res)
+			}
+			if len(res.QuantityRolls) != 2 || res.QuantityRolls[0].First+res.QuantityRolls[1].First != res.Quantity {
+				t.Fatalf("quantity rolls %+v do not add up to %d", res.QuantityRolls, res.Quantity)
+			}
+		case res.Row.Text == "{table:Treasure}":
+			nested++
+			if len(res.Nested) != 1 || res.Text != res.Nested[0].String() || strings.Contains(res.Text, "{table:") {
+				t.Fatalf("reference not expanded: %+v", res)
+			}
+		}
+	}
+	if goblins == 0 || nested == 0 {
+		t.Errorf("rolled %d goblins and %d treasures", goblins, nested)
+	}
+
+}
+
+func TestRollIndependentStreams(t *testing.T) {
+	set := mustParse(t, encounters)
+	busy := NewRoller(set, roll.NewIntSource("test-seed"), "streams")
+	fresh := NewRoller(set, roll.NewIntSource("test-seed"), "streams")
+
+	// Rolling one table does not shift another's stream
+	for i := 0; i < 10; i++ {
+		busy.Roll("Treasure")
+	}
+	a, _ := busy.Roll("Encounters")
+	b, _ := fresh.Roll("Encounters")
+	if a.Total != b.Total {
+		t.Errorf("Encounters rolled %d after other tables, %d on its own", a.Total, b.Total)
+	}
+}
+
+func TestRollErrors(t *testing.T) {
+	doc := "# Gap\n| d6 | Result |\n|---|---|\n| 1-3 | Low |\n\n# Self\n| d4 | Result |\n|---|---|\n| 1-4 | {table:Self} |\n"
+	r := NewRoller(mustParse(t, doc), roll.NewIntSource("test-seed"), "errors")
+
+	if _, err := r.Roll("Unknown"); err == nil {
+		t.Error("rolling an unknown table should fail")
+	}
+	if _, err := r.Roll("Self"); err == nil {
+		t.Error("a reference cycle should fail")
+	}
+	var failed bool
+	for i := 0; i < 50 && !failed; i++ {
+		_, err := r.Roll("Gap")
+		failed = err != nil
+	}
+	if !failed {
+		t.Error("a total with no row should fail")
+	}
+}
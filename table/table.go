@@ -0,0 +1,269 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package table
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// refPattern matches a nested table reference such as {table:Treasure}
+var refPattern = regexp.MustCompile(`\{table:([^}]+)\}`)
+
+// Set is a collection of named tables parsed from one document
+type Set struct {
+	tables map[string]*Table
+	order  []string
+}
+
+// Table is a random table rolled with a table-level die
+type Table struct {
+	// Name is the heading above the table
+	Name string
+
+	// Die is the table die, taken from the first header cell (e.g. d100, d66, 2d6)
+	Die roll.Notation
+
+	// Rows are the table rows in document order
+	Rows []Row
+
+	// Line is the line of the table header
+	Line int
+}
+
+// Row is a range-keyed table row
+type Row struct {
+	// Lower and Upper are the inclusive range of totals selecting the row
+	Lower, Upper int
+
+	// Text is the row result, which may reference other tables as {table:Name}
+	Text string
+
+	// Quantity is how many, as a number or dice expression (empty if the row has none)
+	Quantity string
+
+	// Line is the line of the row
+	Line int
+
+	// dice is the parsed quantity when it is a dice expression, fixed when it is a number
+	dice  *roll.Notation
+	fixed int
+}
+
+// Parse parses Markdown tables, each named by the nearest heading above it
+// The first column holds range keys ("01-05", "06", "96-00" where 00 reads as 100), the second the
+// result and an optional third a quantity (a number or dice expression)
+func Parse(r io.Reader) (*Set, error) {
+	set := &Set{tables: make(map[string]*Table)}
+	scanner := bufio.NewScanner(r)
+
+	var heading string
+	var current *Table
+	header := false
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(text, "|") {
+			current = nil
+			if name, ok := strings.CutPrefix(text, "#"); ok {
+				heading = strings.TrimSpace(strings.TrimLeft(name, "#"))
+			}
+			continue
+		}
+
+		cells := splitRow(text)
+		switch {
+		case current == nil:
+			t, err := set.newTable(heading, cells, line)
+			if err != nil {
+				return nil, err
+			}
+			current, header = t, true
+		case header && isSeparator(cells):
+			header = false
+		default:
+			row, err := parseRow(cells, line)
+			if err != nil {
+				return nil, err
+			}
+			current.Rows = append(current.Rows, row)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("table: %w", err)
+	}
+	return set, nil
+}
+
+// Table returns the table with the given name
+func (s *Set) Table(name string) (*Table, bool) {
+	t, ok := s.tables[name]
+	return t, ok
+}
+
+// Tables returns the tables in document order
+func (s *Set) Tables() []*Table {
+	tables := make([]*Table, len(s.order))
+	for i, name := range s.order {
+		tables[i] = s.tables[name]
+	}
+	return tables
+}
+
+// Probabilities returns the probability of each row under the caster's distribution (0-100%)
+func (t *Table) Probabilities(c roll.Caster[int]) []float64 {
+	odds := t.Die.Odds(c)
+	probs := make([]float64, len(t.Rows))
+	for i, row := range t.Rows {
+		for v, p := range odds {
+			if v >= row.Lower && v <= row.Upper {
+				probs[i] += p
+			}
+		}
+	}
+	return probs
+}
+
+// Lookup returns the first row whose range holds the total
+func (t *Table) Lookup(total int) (Row, bool) {
+	for _, row := range t.Rows {
+		if total >= row.Lower && total <= row.Upper {
+			return row, true
+		}
+	}
+	return Row{}, false
+}
+
+// Refs returns the names of the tables the row references, in order
+func (r Row) Refs() []string {
+	var refs []string
+	for _, m := range refPattern.FindAllStringSubmatch(r.Text, -1) {
+		refs = append(refs, strings.TrimSpace(m[1]))
+	}
+	return refs
+}
+
+// newTable starts a table from its header row
+func (s *Set) newTable(name string, cells []string, line int) (*Table, error) {
+	if name == "" {
+		return nil, fmt.Errorf("table: line %d: table has no heading", line)
+	}
+	if _, ok := s.tables[name]; ok {
+		return nil, fmt.Errorf("table: line %d: duplicate table %q", line, name)
+	}
+	die, err := roll.ParseNotation(cells[0])
+	if err != nil {
+		return nil, fmt.Errorf("table: line %d: header %q is not a die: %w", line, cells[0], err)
+	}
+	t := &Table{Name: name, Die: die, Line: line}
+	s.tables[name] = t
+	s.order = append(s.order, name)
+	return t, nil
+}
+
+// parseRow parses a table row
+func parseRow(cells []string, line int) (Row, error) {
+	if len(cells) < 2 {
+		return Row{}, fmt.Errorf("table: line %d: row needs a key and a result", line)
+	}
+	lower, upper, err := parseKey(cells[0])
+	if err != nil {
+		return Row{}, fmt.Errorf("table: line %d: %w", line, err)
+	}
+	row := Row{Lower: lower, Upper: upper, Text: cells[1], Line: line}
+
+	if len(cells) < 3 || cells[2] == "" {
+		return row, nil
+	}
+	row.Quantity = cells[2]
+	if fixed, err := strconv.Atoi(row.Quantity); err == nil {
+		row.fixed = fixed
+		return row, nil
+	}
+	n, err := roll.ParseNotation(row.Quantity)
+	if err != nil {
+		return Row{}, fmt.Errorf("table: line %d: quantity %q: %w", line, row.Quantity, err)
+	}
+	row.dice = &n
+	return row, nil
+}
+
+// parseKey parses a range key such as "01-05", "01–05" or "11"
+func parseKey(key string) (int, int, error) {
+	key = strings.NewReplacer("–", "-", "—", "-", " ", "").Replace(key)
+	lo, hi, isRange := strings.Cut(key, "-")
+	lower, err := parseKeyValue(lo)
+	if err != nil {
+		return 0, 0, err
+	}
+	upper := lower
+	if isRange {
+		if upper, err = parseKeyValue(hi); err != nil {
+			return 0, 0, err
+		}
+	}
+	if upper < lower {
+		return 0, 0, fmt.Errorf("range %q is reversed", key)
+	}
+	return lower, upper, nil
+}
+
+// parseKeyValue parses one end of a range key, reading all zeros ("00") as 100 and so on
+func parseKeyValue(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || strings.HasPrefix(s, "+") || v < 0 {
+		return 0, fmt.Errorf("invalid key %q", s)
+	}
+	if v == 0 && len(s) > 1 {
+		v = 1
+		for range s {
+			v *= 10
+		}
+	}
+	return v, nil
+}
+
+// splitRow splits a Markdown table row into trimmed cells
+func splitRow(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+	cells := strings.Split(line, "|")
+	for i, c := range cells {
+		cells[i] = strings.TrimSpace(c)
+	}
+	return cells
+}
+
+// isSeparator reports whether the cells form a header separator such as |---|:--:|
+func isSeparator(cells []string) bool {
+	for _, c := range cells {
+		if strings.Trim(c, "-: ") != "" || !strings.Contains(c, "-") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package table
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+const encounters = `# Encounters
+
+| d100  | Result           | Quantity |
+|-------|------------------|----------|
+| 01-50 | Goblins          | 2d4      |
+| 51–90 | {table:Treasure} |          |
+| 91-99 | Ogre             | 1        |
+| 00    | Dragon           |          |
+
+Some notes between tables.
+
+## Treasure
+
+| 2d6   | Result               |
+|:-----:|----------------------|
+| 2-6   | {table:Coins} copper |
+| 7-12  | A gem                |
+
+## Coins
+
+| d6  | Result | Quantity |
+|-----|--------|----------|
+| 1-6 | Purse  | 3d6*10   |
+`
+
+func mustParse(t *testing.T, doc string) *Set {
+	t.Helper()
+	set, err := Parse(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return set
+}
+
+func TestParse(t *testing.T) {
+	set := mustParse(t, encounters)
+
+	tables := set.Tables()
+	if len(tables) != 3 || tables[0].Name != "Encounters" || tables[1].Name != "Treasure" {
+		t.Fatalf("parsed %d tables", len(tables))
+	}
+
+	enc := tables[0]
+	if enc.Die.String() != "d100" || len(enc.Rows) != 4 {
+		t.Fatalf("Encounters: die %s, %d rows", enc.Die, len(enc.Rows))
+	}
+	want := []Row{
+		{Lower: 1, Upper: 50, Text: "Goblins", Quantity: "2d4", Line: 5},
+		{Lower: 51, Upper: 90, Text: "{table:Treasure}", Line: 6},
+		{Lower: 91, Upper: 99, Text: "Ogre", Quantity: "1", Line: 7},
+		{Lower: 100, Upper: 100, Text: "Dragon", Line: 8},
+	}
+	for i, row := range enc.Rows {
+		w := want[i]
+		if row.Lower != w.Lower || row.Upper != w.Upper || row.Text != w.Text || row.Quantity != w.Quantity || row.Line != w.Line {
+			t.Errorf("row %d = %+v, want %+v", i, row, w)
+		}
+	}
+	if refs := enc.Rows[1].Refs(); len(refs) != 1 || refs[0] != "Treasure" {
+		t.Errorf("refs %v", refs)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"no heading":   "| d6 | Result |\n|---|---|\n| 1-6 | x |\n",
+		"not a die":    "# T\n| Roll | Result |\n|---|---|\n| 1-6 | x |\n",
+		"bad key":      "# T\n| d6 | Result |\n|---|---|\n| one | x |\n",
+		"reversed key": "# T\n| d6 | Result |\n|---|---|\n| 6-1 | x |\n",
+		"bad quantity": "# T\n| d6 | Result | Qty |\n|---|---|---|\n| 1-6 | x | lots |\n",
+		"duplicate":    "# T\n| d6 | Result |\n|---|---|\n| 1-6 | x |\n\n# T\n| d6 | Result |\n|---|---|\n| 1-6 | x |\n",
+	}
+	for name, doc := range tests {
+		if _, err := Parse(strings.NewReader(doc)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestProbabilities(t *testing.T) {
+	set := mustParse(t, encounters)
+	uniform := roll.NewIntSource("test-seed").SaltDist("odds")
+
+	enc, _ := set.Table("Encounters")
+	want := []float64{50, 40, 9, 1}
+	for i, p := range enc.Probabilities(uniform) {
+		if math.Abs(p-want[i]) > 1e-9 {
+			t.Errorf("Encounters row %d: %v%%, want %v%%", i, p, want[i])
+		}
+	}
+
+	treasure, _ := set.Table("Treasure")
+	if p := treasure.Probabilities(uniform); math.Abs(p[0]-100.0*15/36) > 1e-9 {
+		t.Errorf("Treasure 2-6: %v%%, want %v%%", p[0], 100.0*15/36)
+	}
+
+	high := roll.NewIntSource("test-seed").Dist(roll.WeightedHigh()).Weight(0.8).SaltDist("odds")
+	if p := enc.Probabilities(high); p[3] <= 1 || p[0] >= 50 {
+		t.Errorf("a high-weighted die should favor the last rows: %v", p)
+	}
+}
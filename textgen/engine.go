@@ -0,0 +1,186 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package textgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/andrei-cosmin/dixe/roll"
+	"github.com/andrei-cosmin/dixe/table"
+)
+
+// Engine renders templates deterministically for a source and salt
+// Dice roll on Derive("textgen", "dice") of the source and choices on Derive("textgen", "choice")
+// with only strict mode kept, both with the salt, and tables roll through a table.Roller,
+// so a new engine with the same source, salt and tables renders the same sequence of texts
+type Engine struct {
+	src    *roll.IntSource
+	salt   string
+	dice   *roll.IntDistCaster
+	choice *roll.IntDistCaster
+	tables *table.Roller
+}
+
+// Rendering is a rendered template and the trace of every roll made
+type Rendering struct {
+	// Text is the rendered text
+	Text string
+
+	// Trace holds one step per expression, in template order
+	Trace []Step
+}
+
+// Step records how an expression was rendered
+type Step struct {
+	// Kind is the kind of expression
+	Kind Kind
+
+	// Expr is the expression between braces
+	Expr string
+
+	// Value is the dice total, the table die total, or the index of the chosen option
+	Value int
+
+	// Rolls holds the dice rolled for a dice expression
+	Rolls []int
+
+	// Results holds each caster roll of a dice expression or choice, with its rerolls and explosions
+	// Table calls keep theirs in Table
+	Results []roll.IntResult
+
+	// Table is the table result of a table call, with its nested results
+	Table *table.Result
+
+	// Output is the text the expression rendered to
+	Output string
+}
+
+// New creates an engine rolling with the source's options and the salt
+func New(src *roll.IntSource, salt string) *Engine {
+	return &Engine{
+		src:    src,
+		salt:   salt,
+		dice:   src.Derive("textgen", "dice").SaltDist(salt),
+		choice: choiceSource(src).SaltDist(salt),
+	}
+}
+
+// choiceSource derives the source for choices, dropping every option except strict mode, since
+// rerolls, explosions or correlation would skew the option weights
+func choiceSource(src *roll.IntSource) *roll.IntSource {
+	return src.Derive("textgen", "choice").
+		Dist(roll.Uniform()).
+		RerollBelow(0).
+		LowerExplosions(0).
+		RerollAbove(0).
+		UpperExplosions(0).
+		Explode().
+		Reroll().
+		Correlation(0)
+}
+
+// Tables sets the tables available to {table:name} calls
+func (e *Engine) Tables(set *table.Set) *Engine {
+	e.tables = table.NewRoller(set, e.src, e.salt)
+	return e
+}
+
+// Render renders a template, continuing the engine's streams
+func (e *Engine) Render(t *Template) (Rendering, error) {
+	var text strings.Builder
+	var trace []Step
+	for _, p := range t.parts {
+		if p.expr == nil {
+			text.WriteString(p.literal)
+			continue
+		}
+		step, err := e.render(p.expr)
+		if err != nil {
+			return Rendering{}, err
+		}
+		text.WriteString(step.Output)
+		trace = append(trace, step)
+	}
+	return Rendering{Text: text.String(), Trace: trace}, nil
+}
+
+// RenderString parses and renders a template
+func (e *Engine) RenderString(text string) (Rendering, error) {
+	t, err := Parse(text)
+	if err != nil {
+		return Rendering{}, err
+	}
+	return e.Render(t)
+}
+
+// String returns the trace as one line per step, e.g. "dice 2d6*10 = 70 [3 4]"
+func (r Rendering) String() string {
+	var b strings.Builder
+	for _, s := range r.Trace {
+		fmt.Fprintf(&b, "%s %s = %d", s.Kind, s.Expr, s.Value)
+		if len(s.Rolls) > 0 {
+			fmt.Fprintf(&b, " %v", s.Rolls)
+		}
+		fmt.Fprintf(&b, " -> %q\n", s.Output)
+	}
+	return b.String()
+}
+
+// render renders a single expression
+func (e *Engine) render(expr *expression) (Step, error) {
+	step := Step{Kind: expr.kind, Expr: expr.text}
+	switch expr.kind {
+	case KindDice:
+		rec := roll.Record[int](e.dice)
+		r := expr.dice.Roll(rec)
+		step.Value, step.Rolls, step.Output = r.Sum, r.Rolls, strconv.Itoa(r.Sum)
+		step.Results = rec.Journal().Results()
+	case KindTable:
+		if e.tables == nil {
+			return Step{}, fmt.Errorf("textgen: no tables for {%s}", expr.text)
+		}
+		res, err := e.tables.Roll(expr.table)
+		if err != nil {
+			return Step{}, fmt.Errorf("textgen: {%s}: %w", expr.text, err)
+		}
+		step.Value, step.Table, step.Output = res.Total, &res, res.String()
+	case KindChoice:
+		var total int
+		for _, w := range expr.weights {
+			total += w
+		}
+		r := e.choice.One(roll.Dice(total))
+		step.Results = []roll.IntResult{r}
+		pick := r.First
+		for i, w := range expr.weights {
+			if pick <= w {
+				step.Value, step.Output = i, expr.options[i]
+				break
+			}
+			pick -= w
+		}
+	}
+	return step, nil
+}
@@ -0,0 +1,182 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package textgen
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/andrei-cosmin/dixe/roll"
+	"github.com/andrei-cosmin/dixe/table"
+)
+
+const weapons = `# weapons
+
+| d6  | Result          |
+|-----|-----------------|
+| 1-3 | sword           |
+| 4-5 | {table:bows}    |
+| 6   | hammer          |
+
+# bows
+
+| d4  | Result   | Quantity |
+|-----|----------|----------|
+| 1-4 | arrows   | 2d10     |
+`
+
+func newEngine(t *testing.T, salt string) *Engine {
+	t.Helper()
+	set, err := table.Parse(strings.NewReader(weapons))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(roll.NewIntSource("test-seed"), salt).Tables(set)
+}
+
+func TestRenderDeterministic(t *testing.T) {
+	tmpl, err := Parse("You find {2d6*10} gold and a {table:weapons} to the {north|south|east|west}.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, b, other := newEngine(t, "quest-1"), newEngine(t, "quest-1"), newEngine(t, "quest-2")
+	var differs bool
+	for i := 0; i < 20; i++ {
+		ra, err := a.Render(tmpl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rb, _ := b.Render(tmpl)
+		ro, _ := other.Render(tmpl)
+		if ra.Text != rb.Text || ra.String() != rb.String() {
+			t.Fatalf("render %d: %q vs %q for the same seed and salt", i, ra.Text, rb.Text)
+		}
+		differs = differs || ra.Text != ro.Text
+	}
+	if !differs {
+		t.Error("different salts should render differently")
+	}
+}
+
+func TestRenderTrace(t *testing.T) {
+	e := newEngine(t, "trace")
+	for i := 0; i < 50; i++ {
+		r, err := e.RenderString("{3d6+1} / {table:weapons} / {a|b:3}")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Trace) != 3 {
+			t.Fatalf("trace has %d steps", len(r.Trace))
+		}
+
+		dice, tbl, choice := r.Trace[0], r.Trace[1], r.Trace[2]
+		sum := 1
+		for _, v := range dice.Rolls {
+			sum += v
+		}
+		if dice.Kind != KindDice || len(dice.Rolls) != 3 || dice.Value != sum || dice.Output != strconv.Itoa(sum) {
+			t.Fatalf("dice step %+v", dice)
+		}
+		if tbl.Kind != KindTable || tbl.Table == nil || tbl.Output != tbl.Table.String() || tbl.Value != tbl.Table.Total {
+			t.Fatalf("table step %+v", tbl)
+		}
+		if choice.Kind != KindChoice || choice.Output != []string{"a", "b"}[choice.Value] {
+			t.Fatalf("choice step %+v", choice)
+		}
+		if r.Text != dice.Output+" / "+tbl.Output+" / "+choice.Output {
+			t.Fatalf("text %q does not match the trace", r.Text)
+		}
+	}
+}
+
+func TestRenderTraceKeepsExplosions(t *testing.T) {
+	src := roll.NewIntSource("test-seed").Explode(roll.ExplodeOnMax[int](2))
+	e := New(src, "explosions")
+	var exploded bool
+	for i := 0; i < 200; i++ {
+		r, err := e.RenderString("{2d6}")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dice := r.Trace[0]
+		if len(dice.Results) != 2 || dice.Results[0].First != dice.Rolls[0] || dice.Results[1].First != dice.Rolls[1] {
+			t.Fatalf("dice results %+v do not match rolls %v", dice.Results, dice.Rolls)
+		}
+		for _, res := range dice.Results {
+			exploded = exploded || len(res.Extras) > 0
+		}
+	}
+	if !exploded {
+		t.Error("trace should keep the explosions of each die")
+	}
+}
+
+func TestRenderChoiceWeights(t *testing.T) {
+	// Choices are uniform by weight even when the source favors high rolls
+	e := New(roll.NewIntSource("test-seed").Dist(roll.WeightedHigh()).Weight(0.9), "weights")
+	tmpl, _ := Parse("{a|b:3}")
+
+	const n = 20_000
+	var b int
+	for i := 0; i < n; i++ {
+		r, _ := e.Render(tmpl)
+		if r.Text == "b" {
+			b++
+		}
+	}
+	if got := float64(b) / n; math.Abs(got-0.75) > 0.02 {
+		t.Errorf("weight 3 of 4 chosen %.3f of the time", got)
+	}
+}
+
+func TestRenderChoiceIgnoresOptions(t *testing.T) {
+	src := roll.NewIntSource("test-seed")
+	tuned := roll.NewIntSource("test-seed").
+		Correlation(0.9).
+		RerollAbove(3).
+		UpperExplosions(2).
+		Explode(roll.ExplodeOnMax[int](3)).
+		Reroll(roll.RerollOnce(roll.Below(3)))
+	tmpl, _ := Parse("{a|b|c|d}")
+
+	plain, skewed := New(src, "options"), New(tuned, "options")
+	for i := 0; i < 200; i++ {
+		want, _ := plain.Render(tmpl)
+		got, _ := skewed.Render(tmpl)
+		if got.Text != want.Text {
+			t.Fatalf("render %d: got %q, want %q", i, got.Text, want.Text)
+		}
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	if _, err := New(roll.NewIntSource("test-seed"), "errors").RenderString("{table:weapons}"); err == nil {
+		t.Error("a table call without tables should fail")
+	}
+	if _, err := newEngine(t, "errors").RenderString("{table:armor}"); err == nil {
+		t.Error("an unknown table should fail")
+	}
+}
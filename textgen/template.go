@@ -0,0 +1,179 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package textgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/andrei-cosmin/dixe/roll"
+)
+
+// maxChoiceWeight bounds the total weight of a choice
+const maxChoiceWeight = 1 << 30
+
+// Kind is the kind of a template expression
+type Kind int
+
+const (
+	// KindDice is an inline dice expression such as {2d6*10}
+	KindDice Kind = iota
+
+	// KindTable is a table call such as {table:weapons}
+	KindTable
+
+	// KindChoice is a weighted choice such as {a|b|c:3}
+	KindChoice
+)
+
+// String returns the kind name
+func (k Kind) String() string {
+	switch k {
+	case KindDice:
+		return "dice"
+	case KindTable:
+		return "table"
+	case KindChoice:
+		return "choice"
+	default:
+		return fmt.Sprintf("kind(%d)", int(k))
+	}
+}
+
+// Template is a parsed template: literal text with {expressions}
+// Expressions are dice notation ({2d6*10}), table calls ({table:name}) and weighted choices
+// ({a|b|c:3}, where only a trailing :digits is a weight and an option without one has weight 1);
+// {{ and }} are literal braces
+type Template struct {
+	text  string
+	parts []part
+}
+
+// part is a literal or an expression of a template
+type part struct {
+	literal string
+	expr    *expression
+}
+
+// expression is a parsed {expression}
+type expression struct {
+	kind    Kind
+	text    string
+	dice    roll.Notation
+	table   string
+	options []string
+	weights []int
+}
+
+// Parse parses a template
+func Parse(text string) (*Template, error) {
+	t := &Template{text: text}
+	var literal strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '{' && strings.HasPrefix(text[i:], "{{"), c == '}' && strings.HasPrefix(text[i:], "}}"):
+			literal.WriteByte(c)
+			i++
+		case c == '}':
+			return nil, fmt.Errorf("textgen: offset %d: unmatched }", i)
+		case c == '{':
+			end := strings.IndexAny(text[i+1:], "{}")
+			if end < 0 || text[i+1+end] == '{' {
+				return nil, fmt.Errorf("textgen: offset %d: unterminated {", i)
+			}
+			expr, err := parseExpression(text[i+1 : i+1+end])
+			if err != nil {
+				return nil, fmt.Errorf("textgen: offset %d: %w", i, err)
+			}
+			if literal.Len() > 0 {
+				t.parts = append(t.parts, part{literal: literal.String()})
+				literal.Reset()
+			}
+			t.parts = append(t.parts, part{expr: expr})
+			i += end + 1
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	if literal.Len() > 0 {
+		t.parts = append(t.parts, part{literal: literal.String()})
+	}
+	return t, nil
+}
+
+// String returns the template source
+func (t *Template) String() string {
+	return t.text
+}
+
+// parseExpression parses the text between braces
+func parseExpression(text string) (*expression, error) {
+	if name, ok := strings.CutPrefix(text, "table:"); ok {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("table call %q has no table name", text)
+		}
+		return &expression{kind: KindTable, text: text, table: name}, nil
+	}
+
+	if strings.Contains(text, "|") {
+		expr := &expression{kind: KindChoice, text: text}
+		var total int
+		for _, option := range strings.Split(text, "|") {
+			weight := 1
+			if i := strings.LastIndexByte(option, ':'); i >= 0 && isDigits(strings.TrimSpace(option[i+1:])) {
+				w, err := strconv.Atoi(strings.TrimSpace(option[i+1:]))
+				if err != nil || w < 1 || w > maxChoiceWeight {
+					return nil, fmt.Errorf("choice %q has an invalid weight %q", text, option[i+1:])
+				}
+				option, weight = option[:i], w
+			}
+			if total += weight; total > maxChoiceWeight {
+				return nil, fmt.Errorf("choice %q weighs more than %d in total", text, maxChoiceWeight)
+			}
+			expr.options = append(expr.options, strings.TrimSpace(option))
+			expr.weights = append(expr.weights, weight)
+		}
+		return expr, nil
+	}
+
+	dice, err := roll.ParseNotation(text)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a dice expression, table call or choice", text)
+	}
+	return &expression{kind: KindDice, text: text, dice: dice}, nil
+}
+
+// isDigits reports whether s is a non-empty run of decimal digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+// MIT License
+//
+// Copyright (c) 2025 Andrei Casu-Pop
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package textgen
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tmpl, err := Parse("You find {2d6*10} gold, a {table:weapons} and {{braces}} {north|south:2| east }")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var kinds []Kind
+	var literals string
+	for _, p := range tmpl.parts {
+		if p.expr != nil {
+			kinds = append(kinds, p.expr.kind)
+		} else {
+			literals += p.literal
+		}
+	}
+	if len(kinds) != 3 || kinds[0] != KindDice || kinds[1] != KindTable || kinds[2] != KindChoice {
+		t.Errorf("kinds %v", kinds)
+	}
+	if literals != "You find  gold, a  and {braces} " {
+		t.Errorf("literals %q", literals)
+	}
+
+	choice := tmpl.parts[len(tmpl.parts)-1].expr
+	if len(choice.options) != 3 || choice.options[2] != "east" || choice.weights[1] != 2 || choice.weights[0] != 1 {
+		t.Errorf("choice options %q weights %v", choice.options, choice.weights)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, text := range []string{
+		"{2d6",
+		"2d6}",
+		"{a {b}}",
+		"{table:}",
+		"{gold}",
+		"{a|b:0}",
+		"{a|b:9223372036854775807}",
+		"{a|b:99999999999999999999}",
+		"{a:1073741824|b}",
+	} {
+		if _, err := Parse(text); err == nil {
+			t.Errorf("Parse(%q) should fail", text)
+		}
+	}
+}
+
+func TestParseChoiceColons(t *testing.T) {
+	tmpl, err := Parse("{Time: noon|Time: dusk : 3|ratio 1:2}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	choice := tmpl.parts[0].expr
+	want := []string{"Time: noon", "Time: dusk", "ratio 1"}
+	for i, w := range []int{1, 3, 2} {
+		if choice.options[i] != want[i] || choice.weights[i] != w {
+			t.Errorf("option %d = %q weight %d, want %q weight %d", i, choice.options[i], choice.weights[i], want[i], w)
+		}
+	}
+}